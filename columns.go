@@ -0,0 +1,204 @@
+package tabular
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoHeaders is returned when column operations are applied to dataset without headers.
+	ErrNoHeaders = errors.New("dataset has no headers")
+
+	// ErrLastColumn is returned when removing the only column of the dataset.
+	ErrLastColumn = errors.New("can not remove the last column")
+)
+
+// ErrDuplicateColumn is error returned when adding column with already used key.
+type ErrDuplicateColumn struct {
+	key string
+}
+
+func (e ErrDuplicateColumn) Error() string {
+	return fmt.Sprintf("Column %s already exists.", e.key)
+}
+
+// ErrInvalidColumnLength is error returned when adding column with invalid number of values.
+type ErrInvalidColumnLength struct {
+	actual   int
+	expected int
+}
+
+func (e ErrInvalidColumnLength) Error() string {
+	return fmt.Sprintf("Invalid column length = %d, expected = %d.", e.actual, e.expected)
+}
+
+// Column operations rebuild rows of the dataset instead of modifying them,
+// so datasets derived by query methods sharing the rows are not affected.
+
+// AddColumn appends new column with values, one for each row.
+func (d *Dataset) AddColumn(key string, title string, values []string) error {
+	if len(values) != d.Len() {
+		return ErrInvalidColumnLength{
+			actual:   len(values),
+			expected: d.Len(),
+		}
+	}
+
+	var idx int
+	return d.AddComputedColumn(key, title, func(*Row) string {
+		val := values[idx]
+		idx++
+		return val
+	})
+}
+
+// AddComputedColumn appends new column with values computed by fn for each row.
+func (d *Dataset) AddComputedColumn(key string, title string, fn func(*Row) string) error {
+	if err := d.checkNewColumn(key); err != nil {
+		return err
+	}
+
+	idxs := d.columnIndexes()
+	for i, row := range d.rows {
+		nr := row.project(idxs)
+		nr.Add(fn(row))
+		d.rows[i] = nr
+	}
+
+	d.AddHeader(key, title)
+	d.updateAllLengths()
+	return nil
+}
+
+// RemoveColumn removes column from the dataset, the only column of the
+// dataset can not be removed.
+func (d *Dataset) RemoveColumn(key string) error {
+	idx, err := d.columnIndex(key)
+	if err != nil {
+		return err
+	}
+	if d.HeaderCount() == 1 {
+		return ErrLastColumn
+	}
+
+	idxs := d.columnIndexes()
+	idxs = append(idxs[:idx], idxs[idx+1:]...)
+	d.projectColumns(idxs)
+	return nil
+}
+
+// RenameColumn changes key of the column, its title is kept.
+func (d *Dataset) RenameColumn(oldKey string, newKey string) error {
+	idx, err := d.columnIndex(oldKey)
+	if err != nil {
+		return err
+	}
+	if oldKey == newKey {
+		return nil
+	}
+	if d.HasCol(newKey) {
+		return ErrDuplicateColumn{newKey}
+	}
+
+	hdr, _ := d.GetHeader(idx)
+	hdr.Key = newKey
+	return nil
+}
+
+// MoveColumn moves column to the position pos, other columns are shifted.
+func (d *Dataset) MoveColumn(key string, pos int) error {
+	idx, err := d.columnIndex(key)
+	if err != nil {
+		return err
+	}
+	if pos < 0 || pos >= d.HeaderCount() {
+		return ErrInvalidHeaderIndex{pos}
+	}
+
+	idxs := d.columnIndexes()
+	idxs = append(idxs[:idx], idxs[idx+1:]...)
+	idxs = append(idxs[:pos], append([]int{idx}, idxs[pos:]...)...)
+	d.projectColumns(idxs)
+	return nil
+}
+
+// SelectColumns returns new dataset holding only given columns in given order.
+func (d *Dataset) SelectColumns(keys ...string) (*Dataset, error) {
+	idxs, err := d.columnIndexesOf(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	nd := d.derive(d.rows)
+	nd.projectColumns(idxs)
+	return nd, nil
+}
+
+// projectColumns rearranges headers and rows to hold columns on given indexes.
+func (d *Dataset) projectColumns(idxs []int) {
+	items := make([]*Header, 0, len(idxs))
+	for _, idx := range idxs {
+		items = append(items, d.headers.items[idx])
+	}
+	d.headers.items = items
+	d.updateHeaders()
+
+	for i, row := range d.rows {
+		d.rows[i] = row.project(idxs)
+	}
+	d.updateAllLengths()
+}
+
+func (d *Dataset) checkNewColumn(key string) error {
+	if !d.HasHeaders() && d.Len() > 0 {
+		return ErrNoHeaders
+	}
+	if d.HasCol(key) {
+		return ErrDuplicateColumn{key}
+	}
+	return nil
+}
+
+func (d *Dataset) columnIndex(key string) (int, error) {
+	if !d.HasHeaders() {
+		return 0, ErrNoHeaders
+	}
+	idx, ok := d.getColumnIndex(key)
+	if !ok {
+		return 0, ErrColumnNotFound{key}
+	}
+	return idx, nil
+}
+
+func (d *Dataset) columnIndexesOf(keys []string) ([]int, error) {
+	idxs := make([]int, 0, len(keys))
+	for _, key := range keys {
+		idx, err := d.columnIndex(key)
+		if err != nil {
+			return nil, err
+		}
+		idxs = append(idxs, idx)
+	}
+	return idxs, nil
+}
+
+func (d *Dataset) columnIndexes() []int {
+	return sequence(d.HeaderCount())
+}
+
+// sequence returns indexes from 0 up to n.
+func sequence(n int) []int {
+	idxs := make([]int, n)
+	for idx := range idxs {
+		idxs[idx] = idx
+	}
+	return idxs
+}
+
+func (d *Dataset) updateAllLengths() {
+	d.lengths = nil
+	d.widths = nil
+	for _, row := range d.rows {
+		d.updateLengths(row)
+	}
+}
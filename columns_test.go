@@ -0,0 +1,157 @@
+package tabular
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ColumnsTestSuite struct {
+	suite.Suite
+}
+
+func (s *ColumnsTestSuite) keys(d *Dataset) []string {
+	var keys []string
+	for _, hdr := range d.Headers() {
+		keys = append(keys, hdr.Key)
+	}
+	return keys
+}
+
+func (s *ColumnsTestSuite) TestAddColumn() {
+	d, err := newTestDataset()
+	s.NoError(err)
+	found := d.Filter(func(*Row) bool { return true })
+
+	s.NoError(d.AddColumn("country", "Country", []string{"USA", "United States"}))
+	s.Equal([]string{"name", "surname", "age", "country"}, s.keys(d))
+	s.Equal([]string{"USA", "United States"}, d.GetColValues("country"))
+	s.Equal(13, d.GetKeyWidth("country"))
+
+	row, _ := found.Get(0)
+	s.Equal(3, row.Len())
+	s.NoError(d.Append(NewRow("Bill", "Murray", "70", "USA")))
+
+	err = d.AddColumn("city", "City", []string{"x"})
+	s.Equal(ErrInvalidColumnLength{actual: 1, expected: 3}, err)
+	s.Equal("Invalid column length = 1, expected = 3.", err.Error())
+
+	err = d.AddColumn("name", "Name", []string{"x", "y", "z"})
+	s.Equal(ErrDuplicateColumn{"name"}, err)
+	s.Equal("Column name already exists.", err.Error())
+}
+
+func (s *ColumnsTestSuite) TestAddComputedColumn() {
+	d, err := newTestDataset()
+	s.NoError(err)
+	r1, _ := d.Get(0)
+	r1.AddTag("tag")
+	r1.SetNull(2)
+
+	err = d.AddComputedColumn("full", "Full name", func(r *Row) string {
+		return r.Get(0) + " " + r.Get(1)
+	})
+	s.NoError(err)
+	s.Equal([]string{"Julia Roberts", "John Malkovich"}, d.GetColValues("full"))
+	s.Equal(14, d.GetKeyWidth("full"))
+
+	row, _ := d.Get(0)
+	s.True(row.HasTag("tag"))
+	s.True(row.IsNull(2))
+}
+
+func (s *ColumnsTestSuite) TestRemoveColumn() {
+	d, err := newTestDataset()
+	s.NoError(err)
+
+	s.NoError(d.RemoveColumn("surname"))
+	s.Equal([]string{"name", "age"}, s.keys(d))
+	row, _ := d.Get(1)
+	s.Equal([]string{"John", "42"}, row.Items())
+	s.Equal(3, d.GetIdxWidth(1))
+	s.NoError(d.Append(NewRow("Bill", "70")))
+
+	s.Equal(ErrColumnNotFound{"surname"}, d.RemoveColumn("surname"))
+
+	out, err := newTestWrite(d, NewCSVWriter(&CSVOpts{Comma: ','}))
+	s.NoError(err)
+	s.Equal("First name,Age\nJulia,40\nJohn,42\nBill,70\n", out)
+
+	s.NoError(d.RemoveColumn("name"))
+	s.Equal(ErrLastColumn, d.RemoveColumn("age"))
+	s.Equal([]string{"age"}, s.keys(d))
+	s.Equal([]string{"40", "42", "70"}, d.GetColValues("age"))
+	s.NoError(d.Append(NewRow("18")))
+}
+
+func (s *ColumnsTestSuite) TestRenameColumn() {
+	d, err := newTestDataset()
+	s.NoError(err)
+
+	s.NoError(d.RenameColumn("surname", "last_name"))
+	s.Equal([]string{"name", "last_name", "age"}, s.keys(d))
+	s.Equal([]string{"Roberts", "Malkovich"}, d.GetColValues("last_name"))
+	hdr, _ := d.GetHeader(1)
+	s.Equal("Last name", hdr.Title)
+
+	s.NoError(d.RenameColumn("age", "age"))
+	s.Equal(ErrDuplicateColumn{"name"}, d.RenameColumn("age", "name"))
+	s.Equal(ErrColumnNotFound{"surname"}, d.RenameColumn("surname", "x"))
+}
+
+func (s *ColumnsTestSuite) TestMoveColumn() {
+	d, err := newTestDataset()
+	s.NoError(err)
+
+	s.NoError(d.MoveColumn("age", 0))
+	s.Equal([]string{"age", "name", "surname"}, s.keys(d))
+	row, _ := d.Get(0)
+	s.Equal([]string{"40", "Julia", "Roberts"}, row.Items())
+	s.Equal(10, d.GetIdxWidth(1))
+
+	s.NoError(d.MoveColumn("age", 2))
+	s.Equal([]string{"name", "surname", "age"}, s.keys(d))
+
+	s.NoError(d.MoveColumn("name", 1))
+	s.Equal([]string{"surname", "name", "age"}, s.keys(d))
+
+	s.Equal(ErrInvalidHeaderIndex{3}, d.MoveColumn("name", 3))
+	s.Equal(ErrColumnNotFound{"x"}, d.MoveColumn("x", 0))
+}
+
+func (s *ColumnsTestSuite) TestSelectColumns() {
+	d, err := newTestDataset()
+	s.NoError(err)
+
+	sel, err := d.SelectColumns("age", "name")
+	s.NoError(err)
+	s.Equal([]string{"age", "name"}, s.keys(sel))
+	row, _ := sel.Get(1)
+	s.Equal([]string{"42", "John"}, row.Items())
+
+	s.Equal([]string{"name", "surname", "age"}, s.keys(d))
+	row, _ = d.Get(1)
+	s.Equal([]string{"John", "Malkovich", "42"}, row.Items())
+
+	out, err := newTestWrite(sel, NewCSVWriter(&CSVOpts{Comma: ';'}))
+	s.NoError(err)
+	s.True(strings.HasPrefix(out, "Age;First name\n40;Julia\n"))
+
+	_, err = d.SelectColumns("name", "missing")
+	s.Equal(ErrColumnNotFound{"missing"}, err)
+}
+
+func (s *ColumnsTestSuite) TestWithoutHeaders() {
+	d := NewDataSet()
+	s.NoError(d.Append(NewRow("a", "b")))
+
+	s.Equal(ErrNoHeaders, d.AddColumn("c", "C", []string{"c"}))
+	s.Equal(ErrNoHeaders, d.RemoveColumn("a"))
+	s.Equal(ErrNoHeaders, d.RenameColumn("a", "b"))
+	s.Equal(ErrNoHeaders, d.MoveColumn("a", 0))
+}
+
+func TestColumnsTestSuite(t *testing.T) {
+	suite.Run(t, new(ColumnsTestSuite))
+}
@@ -0,0 +1,91 @@
+package tabular
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	// ErrEmptyDatabook is returned when operations are applied to empty databook.
+	ErrEmptyDatabook = errors.New("databook is empty")
+
+	// ErrEmptySheetName is returned when writing sheet with empty name to format requiring names.
+	ErrEmptySheetName = errors.New("sheet name is empty")
+)
+
+// ErrDuplicateSheet is error returned when adding sheet with already used name.
+type ErrDuplicateSheet struct {
+	name string
+}
+
+func (e ErrDuplicateSheet) Error() string {
+	return fmt.Sprintf("Sheet %s already exists.", e.name)
+}
+
+// NewDatabook creates new databook.
+func NewDatabook() *Databook {
+	b := &Databook{}
+	return b
+}
+
+// Sheet represents a named dataset of databook.
+type Sheet struct {
+	Name    string
+	Dataset *Dataset
+}
+
+// Databook represents an ordered set of named datasets.
+type Databook struct {
+	sheets []*Sheet
+}
+
+// AddSheet appends new named dataset to the databook.
+func (b *Databook) AddSheet(name string, d *Dataset) error {
+	if _, ok := b.GetSheet(name); ok {
+		return ErrDuplicateSheet{name}
+	}
+	b.sheets = append(b.sheets, &Sheet{
+		Name:    name,
+		Dataset: d,
+	})
+	return nil
+}
+
+// GetSheet returns dataset of sheet by its name.
+func (b *Databook) GetSheet(name string) (*Dataset, bool) {
+	for _, sheet := range b.sheets {
+		if sheet.Name == name {
+			return sheet.Dataset, true
+		}
+	}
+	return nil, false
+}
+
+// Sheets returns a slice of sheets.
+func (b *Databook) Sheets() []*Sheet {
+	return b.sheets
+}
+
+// Len returns the sheet count.
+func (b *Databook) Len() int {
+	return len(b.sheets)
+}
+
+// Write writes databook using book writer to writer.
+func (b *Databook) Write(bw BookWriter, w io.Writer) error {
+	if b.Len() == 0 {
+		return ErrEmptyDatabook
+	}
+
+	for _, sheet := range b.sheets {
+		if sheet.Dataset.Len() == 0 {
+			return ErrEmptyDataset
+		}
+		if bw.NeedsHeaders() && !sheet.Dataset.HasHeaders() {
+			return ErrHeadersRequired{bw.Name()}
+		}
+	}
+
+	return bw.WriteBook(b, w)
+}
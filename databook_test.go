@@ -0,0 +1,122 @@
+package tabular
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type mockBookWriter struct {
+	needsHeaders bool
+}
+
+func (mw *mockBookWriter) Name() string {
+	return "mock"
+}
+
+func (mw *mockBookWriter) NeedsHeaders() bool {
+	return mw.needsHeaders
+}
+
+func (mw *mockBookWriter) WriteBook(b *Databook, w io.Writer) error {
+	return nil
+}
+
+func newTestDatabook() (*Databook, error) {
+	actors, err := newTestDataset()
+	if err != nil {
+		return nil, err
+	}
+
+	totals := NewDataSet()
+	totals.AddHeader("count", "Count")
+	if err := totals.Append(NewRow("2")); err != nil {
+		return nil, err
+	}
+
+	b := NewDatabook()
+	if err := b.AddSheet("Actors", actors); err != nil {
+		return nil, err
+	}
+	if err := b.AddSheet("Totals", totals); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func newTestWriteBook(b *Databook, w BookWriter) (string, error) {
+	var buf bytes.Buffer
+	bufw := bufio.NewWriter(&buf)
+	if err := b.Write(w, bufw); err != nil {
+		return "", err
+	}
+	if err := bufw.Flush(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+type DatabookTestSuite struct {
+	suite.Suite
+}
+
+func (s *DatabookTestSuite) TestSheets() {
+	b, err := newTestDatabook()
+	s.NoError(err)
+	s.Equal(2, b.Len())
+
+	d, ok := b.GetSheet("Totals")
+	s.True(ok)
+	s.Equal(1, d.Len())
+
+	_, ok = b.GetSheet("Missing")
+	s.False(ok)
+
+	var names []string
+	for _, sheet := range b.Sheets() {
+		names = append(names, sheet.Name)
+	}
+	s.Equal([]string{"Actors", "Totals"}, names)
+}
+
+func (s *DatabookTestSuite) TestAddDuplicateSheet() {
+	b, err := newTestDatabook()
+	s.NoError(err)
+
+	err = b.AddSheet("Actors", NewDataSet())
+	s.Equal(ErrDuplicateSheet{"Actors"}, err)
+	s.Equal(2, b.Len())
+}
+
+func (s *DatabookTestSuite) TestWriteEmptyDatabook() {
+	b := NewDatabook()
+	err := b.Write(&mockBookWriter{}, nil)
+	s.Equal(ErrEmptyDatabook, err)
+}
+
+func (s *DatabookTestSuite) TestWriteEmptySheet() {
+	b := NewDatabook()
+	s.NoError(b.AddSheet("Empty", NewDataSet()))
+
+	err := b.Write(&mockBookWriter{}, nil)
+	s.Equal(ErrEmptyDataset, err)
+}
+
+func (s *DatabookTestSuite) TestWriteHeadersRequired() {
+	d := NewDataSet()
+	s.NoError(d.Append(NewRow("john")))
+
+	b := NewDatabook()
+	s.NoError(b.AddSheet("People", d))
+
+	err := b.Write(&mockBookWriter{needsHeaders: true}, nil)
+	s.Equal(ErrHeadersRequired{"mock"}, err)
+	s.Equal("Writer mock needs headers.", err.Error())
+}
+
+func TestDatabookTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabookTestSuite))
+}
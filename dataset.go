@@ -23,11 +23,11 @@ func (e ErrInvalidRowWidth) Error() string {
 
 // ErrHeadersRequired is error returned when writer is missing the headers.
 type ErrHeadersRequired struct {
-	w Writer
+	writer string
 }
 
 func (e ErrHeadersRequired) Error() string {
-	return fmt.Sprintf("Writer %s needs headers.", e.w.Name())
+	return fmt.Sprintf("Writer %s needs headers.", e.writer)
 }
 
 // NewDataSet creates new dataset.
@@ -38,6 +38,11 @@ func NewDataSet() *Dataset {
 	return d
 }
 
+// LoadDataset loads new dataset using dataset reader from reader.
+func LoadDataset(dr Reader, r io.Reader) (*Dataset, error) {
+	return dr.Read(r)
+}
+
 // Dataset represents a set of data.
 type Dataset struct {
 	headers *Headers
@@ -45,6 +50,7 @@ type Dataset struct {
 
 	cols    int
 	lengths map[int]int
+	widths  map[int]int
 }
 
 // AddHeader adds new header.
@@ -53,6 +59,13 @@ func (d *Dataset) AddHeader(key string, title string) {
 	d.updateHeaders()
 }
 
+// AddTypedHeader adds new header with column type. Nullable columns accept
+// empty values which are written as nulls.
+func (d *Dataset) AddTypedHeader(key string, title string, typ ColumnType, nullable bool) {
+	d.headers.AddTyped(key, title, typ, nullable)
+	d.updateHeaders()
+}
+
 // GetHeader returns header by its index.
 func (d *Dataset) GetHeader(idx int) (*Header, bool) {
 	return d.headers.Get(idx)
@@ -103,7 +116,7 @@ func (d *Dataset) GetColValues(key string) []string {
 	return nil
 }
 
-// GetKeyWidth returns maximum column width.
+// GetKeyWidth returns maximum column width in bytes.
 func (d *Dataset) GetKeyWidth(key string) int {
 	if idx, ok := d.getColumnIndex(key); ok {
 		return d.getIndexWidth(idx)
@@ -111,12 +124,35 @@ func (d *Dataset) GetKeyWidth(key string) int {
 	return 0
 }
 
-// GetIdxWidth returns maximum column width.
+// GetIdxWidth returns maximum column width in bytes.
 func (d *Dataset) GetIdxWidth(idx int) int {
 	return d.getIndexWidth(idx)
 }
 
-// Find filters dataset for tag.
+// GetKeyDisplayWidth returns maximum column width in terminal display cells.
+func (d *Dataset) GetKeyDisplayWidth(key string) int {
+	if idx, ok := d.getColumnIndex(key); ok {
+		return d.getIndexDisplayWidth(idx)
+	}
+	return 0
+}
+
+// GetIdxDisplayWidth returns maximum column width in terminal display cells.
+func (d *Dataset) GetIdxDisplayWidth(idx int) int {
+	return d.getIndexDisplayWidth(idx)
+}
+
+// Clone returns a deep copy of the dataset, headers and rows of the copy
+// can be modified without affecting the original dataset.
+func (d *Dataset) Clone() *Dataset {
+	rows := make([]*Row, 0, len(d.rows))
+	for _, row := range d.rows {
+		rows = append(rows, row.Clone())
+	}
+	return d.derive(rows)
+}
+
+// Find returns new dataset with rows having the tag.
 func (d *Dataset) Find(tag string) *Dataset {
 	var rows []*Row
 	for _, row := range d.rows {
@@ -124,11 +160,10 @@ func (d *Dataset) Find(tag string) *Dataset {
 			rows = append(rows, row)
 		}
 	}
-	d.rows = rows
-	return d
+	return d.derive(rows)
 }
 
-// FindAny filters dataset for any tags.
+// FindAny returns new dataset with rows having any of the tags.
 func (d *Dataset) FindAny(tags ...string) *Dataset {
 	var rows []*Row
 	for _, row := range d.rows {
@@ -136,11 +171,10 @@ func (d *Dataset) FindAny(tags ...string) *Dataset {
 			rows = append(rows, row)
 		}
 	}
-	d.rows = rows
-	return d
+	return d.derive(rows)
 }
 
-// FindAll filters dataset for all tags.
+// FindAll returns new dataset with rows having all of the tags.
 func (d *Dataset) FindAll(tags ...string) *Dataset {
 	var rows []*Row
 	for _, row := range d.rows {
@@ -148,27 +182,28 @@ func (d *Dataset) FindAll(tags ...string) *Dataset {
 			rows = append(rows, row)
 		}
 	}
-	d.rows = rows
-	return d
+	return d.derive(rows)
 }
 
-// Slice returns sliced dataset.
+// Slice returns new dataset with rows from start up to end.
 func (d *Dataset) Slice(start int, end int) *Dataset {
-	d.rows = d.rows[start:end]
-	return d
+	return d.derive(d.rows[start:end])
 }
 
-// Sort sorts dataset by key, set reverse to inverse the order direction.
+// Sort returns new dataset sorted by key, set reverse to inverse the order direction.
 func (d *Dataset) Sort(key string, reverse bool) *Dataset {
+	rows := make([]*Row, len(d.rows))
+	copy(rows, d.rows)
+
 	if idx, ok := d.getColumnIndex(key); ok {
 		sorter := &RowSorter{
-			rows:    d.rows,
+			rows:    rows,
 			idx:     idx,
 			reverse: reverse,
 		}
-		d.rows = sorter.Sort()
+		rows = sorter.Sort()
 	}
-	return d
+	return d.derive(rows)
 }
 
 // Write writes dataset using dataset writer to writer.
@@ -178,7 +213,7 @@ func (d *Dataset) Write(dw Writer, w io.Writer) error {
 	}
 
 	if dw.NeedsHeaders() && d.headers.Empty() {
-		return ErrHeadersRequired{dw}
+		return ErrHeadersRequired{dw.Name()}
 	}
 
 	return dw.Write(d, w)
@@ -202,6 +237,28 @@ func (d *Dataset) Len() int {
 	return len(d.rows)
 }
 
+// isNull checks if item on given index of the row is null, typed headers
+// are taken into account when present.
+func (d *Dataset) isNull(r *Row, idx int) bool {
+	if hdr, ok := d.GetHeader(idx); ok {
+		return hdr.isNull(r, idx)
+	}
+	return r.IsNull(idx)
+}
+
+// derive returns new dataset with copy of headers holding given rows, rows
+// are shared with the original dataset.
+func (d *Dataset) derive(rows []*Row) *Dataset {
+	nd := &Dataset{
+		headers: d.headers.clone(),
+		rows:    make([]*Row, len(rows)),
+		cols:    d.cols,
+	}
+	copy(nd.rows, rows)
+	nd.updateAllLengths()
+	return nd
+}
+
 func (d *Dataset) validateRow(r *Row) error {
 	cols := r.Len()
 	if cols < 1 {
@@ -223,6 +280,12 @@ func (d *Dataset) validateRow(r *Row) error {
 		}
 	}
 
+	for idx, hdr := range d.headers.Items() {
+		if err := hdr.validate(r, idx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -238,12 +301,17 @@ func (d *Dataset) updateLengths(r *Row) {
 	if d.lengths == nil {
 		d.lengths = make(map[int]int)
 	}
+	if d.widths == nil {
+		d.widths = make(map[int]int)
+	}
 
 	for idx, item := range r.Items() {
-		l := len(item)
-		if l > d.lengths[idx] {
+		if l := len(item); l > d.lengths[idx] {
 			d.lengths[idx] = l
 		}
+		if w := displayWidth(item); w > d.widths[idx] {
+			d.widths[idx] = w
+		}
 	}
 }
 
@@ -257,24 +325,30 @@ func (d *Dataset) getColumnIndex(key string) (int, bool) {
 }
 
 func (d *Dataset) getIndexWidth(idx int) int {
-	if d.isValidIndex(idx) {
-		length := d.lengths[idx]
-		if d.HasHeaders() {
-			if h, ok := d.GetHeader(idx); ok {
-				hdrWidth := len(h.Title)
-				if hdrWidth > length {
-					return hdrWidth
-				}
-			}
+	length := d.lengths[idx]
+	if h, ok := d.GetHeader(idx); ok {
+		hdrWidth := len(h.Title)
+		if hdrWidth > length {
+			return hdrWidth
+		}
+	}
+	return length
+}
+
+func (d *Dataset) getIndexDisplayWidth(idx int) int {
+	width := d.widths[idx]
+	if h, ok := d.GetHeader(idx); ok {
+		hdrWidth := displayWidth(h.Title)
+		if hdrWidth > width {
+			return hdrWidth
 		}
-		return length
 	}
-	return d.lengths[idx]
+	return width
 }
 
 func (d *Dataset) isValidIndex(idx int) bool {
 	if d.Len() == 0 {
 		return false
 	}
-	return idx >= 0 && idx <= d.Len()
+	return idx >= 0 && idx < d.Len()
 }
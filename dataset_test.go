@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -48,6 +49,18 @@ func (mw *mockWriter) Write(d *Dataset, w io.Writer) error {
 	return nil
 }
 
+type mockReader struct {
+	d *Dataset
+}
+
+func (mr *mockReader) Name() string {
+	return "mock"
+}
+
+func (mr *mockReader) Read(r io.Reader) (*Dataset, error) {
+	return mr.d, nil
+}
+
 func newTestDataset() (*Dataset, error) {
 	d := NewDataSet()
 	for _, hdr := range testHeaders {
@@ -62,6 +75,42 @@ func newTestDataset() (*Dataset, error) {
 	return d, nil
 }
 
+func newTestTypedDataset() (*Dataset, error) {
+	d := NewDataSet()
+	d.AddTypedHeader("name", "Name", TypeString, false)
+	d.AddTypedHeader("age", "Age", TypeInt, false)
+	d.AddTypedHeader("height", "Height", TypeFloat, true)
+	d.AddTypedHeader("active", "Active", TypeBool, false)
+	d.AddTypedHeader("born", "Born", TypeTime, true)
+	d.AddTypedHeader("salary", "Salary", TypeDecimal, true)
+
+	err := d.Append(
+		NewRow("Julia", "40", "1.75", "true", "1967-10-28", "1200.50"),
+		NewRow("007", "42", "", "0", "", ""),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func newTestNullDataset() (*Dataset, error) {
+	d := NewDataSet()
+	for _, hdr := range testHeaders {
+		d.AddHeader(hdr.Key, hdr.Title)
+	}
+
+	r1 := NewRow("Julia", "Roberts")
+	r1.AddNull()
+	r2 := NewRow("John", "", "42")
+	r2.SetNull(1)
+
+	if err := d.Append(r1, r2); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
 func newTestWrite(d *Dataset, w Writer) (string, error) {
 	var buf bytes.Buffer
 	bufw := bufio.NewWriter(&buf)
@@ -115,11 +164,12 @@ func (s *DatasetTestSuite) TestSort() {
 	r3 := NewRow("peter", "kafka")
 
 	s.NoError(d.Append(r1, r2, r3))
-	d.Sort("name", false)
+	sorted := d.Sort("name", false)
+	s.Equal([]*Row{r1, r2, r3}, d.Rows())
 
-	e1, _ := d.Get(0)
-	e2, _ := d.Get(1)
-	e3, _ := d.Get(2)
+	e1, _ := sorted.Get(0)
+	e2, _ := sorted.Get(1)
+	e3, _ := sorted.Get(2)
 
 	s.Equal(e1, r1)
 	s.Equal(e2, r2)
@@ -136,17 +186,80 @@ func (s *DatasetTestSuite) TestSortReverse() {
 	r3 := NewRow("peter", "kafka")
 
 	s.NoError(d.Append(r1, r2, r3))
-	d.Sort("name", true)
+	sorted := d.Sort("name", true)
+	s.Equal([]*Row{r1, r2, r3}, d.Rows())
 
-	e1, _ := d.Get(0)
-	e2, _ := d.Get(1)
-	e3, _ := d.Get(2)
+	e1, _ := sorted.Get(0)
+	e2, _ := sorted.Get(1)
+	e3, _ := sorted.Get(2)
 
 	s.Equal(e1, r3)
 	s.Equal(e2, r2)
 	s.Equal(e3, r1)
 }
 
+func (s *DatasetTestSuite) TestFind() {
+	d, err := newTestDataset()
+	s.NoError(err)
+	r1, _ := d.Get(0)
+	r2, _ := d.Get(1)
+	r1.AddTag("actress")
+	r1.AddTag("oscar")
+	r2.AddTag("actor")
+
+	found := d.Find("actress")
+	s.Equal([]*Row{r1}, found.Rows())
+	s.Equal(2, d.Len())
+	s.Equal(7, found.lengths[1])
+	s.Equal(9, d.lengths[1])
+
+	s.Equal([]*Row{r1, r2}, d.FindAny("oscar", "actor").Rows())
+	s.Equal([]*Row{r1}, d.FindAll("actress", "oscar").Rows())
+	s.Equal(0, d.FindAll("actor", "oscar").Len())
+	s.Equal(d.HeaderCount(), d.FindAll("actor", "oscar").HeaderCount())
+}
+
+func (s *DatasetTestSuite) TestSlice() {
+	d, err := newTestDataset()
+	s.NoError(err)
+	r2, _ := d.Get(1)
+
+	sliced := d.Slice(1, 2)
+	s.Equal([]*Row{r2}, sliced.Rows())
+	s.Equal(2, d.Len())
+
+	s.NoError(sliced.Append(NewRow("Bill", "Murray", "70")))
+	s.Equal(2, sliced.Len())
+	s.Equal(2, d.Len())
+	r, _ := d.Get(1)
+	s.Equal(r2, r)
+}
+
+func (s *DatasetTestSuite) TestClone() {
+	d, err := newTestTypedDataset()
+	s.NoError(err)
+	r1, _ := d.Get(0)
+	r1.AddTag("tag")
+	r1.SetNull(2)
+
+	c := d.Clone()
+	s.Equal(d.Len(), c.Len())
+	c1, _ := c.Get(0)
+	s.Equal(r1.Items(), c1.Items())
+	s.True(c1.IsNull(2))
+	s.True(c1.HasTag("tag"))
+
+	c1.Set(0, "Juliette")
+	hdr, _ := c.GetHeader(0)
+	hdr.Title = "Full name"
+	s.Equal("Julia", r1.Get(0))
+	orig, _ := d.GetHeader(0)
+	s.Equal("Name", orig.Title)
+
+	c.AddHeader("extra", "Extra")
+	s.Equal(6, d.HeaderCount())
+}
+
 func (s *DatasetTestSuite) TestHasColumns() {
 	d := NewDataSet()
 	d.AddHeader("name", "Name")
@@ -191,6 +304,85 @@ func (s *DatasetTestSuite) TestColWidth() {
 	s.Equal(0, d.GetIdxWidth(23))
 }
 
+func (s *DatasetTestSuite) TestColWidthHeaders() {
+	d := NewDataSet()
+	d.AddHeader("a", "A")
+	d.AddHeader("b", "B")
+	d.AddHeader("c", "Long title")
+
+	s.NoError(d.Append(NewRow("x", "y", "z")))
+
+	s.Equal(10, d.GetIdxWidth(2))
+	s.Equal(0, d.GetIdxWidth(3))
+}
+
+func (s *DatasetTestSuite) TestColDisplayWidth() {
+	d := NewDataSet()
+	d.AddHeader("name", "Name")
+	d.AddHeader("city", "City")
+
+	s.NoError(d.Append(
+		NewRow("Čapek", "東京"),
+		NewRow("Zeman", "Praha"),
+	))
+
+	s.Equal(6, d.GetKeyWidth("name"))
+	s.Equal(5, d.GetKeyDisplayWidth("name"))
+	s.Equal(6, d.GetIdxWidth(1))
+	s.Equal(5, d.GetIdxDisplayWidth(1))
+	s.Equal(0, d.GetKeyDisplayWidth("not"))
+}
+
+func (s *DatasetTestSuite) TestTypedColumns() {
+	d, err := newTestTypedDataset()
+	s.NoError(err)
+
+	hdr, _ := d.GetHeader(1)
+	s.Equal(TypeInt, hdr.Type)
+	s.False(hdr.Nullable)
+
+	err = d.Append(NewRow("John", "old", "", "true", "", ""))
+	s.Equal(ErrInvalidValue{key: "age", value: "old", typ: TypeInt}, err)
+	s.Equal(`Invalid int value "old" in column age.`, err.Error())
+
+	err = d.Append(NewRow("John", "", "", "true", "", ""))
+	s.Error(err)
+
+	err = d.Append(NewRow("John", "1", "", "yes", "", ""))
+	s.Error(err)
+
+	err = d.Append(NewRow("John", "1", "", "true", "tomorrow", ""))
+	s.Error(err)
+
+	err = d.Append(NewRow("John", "1", "", "true", "", "1,5"))
+	s.Error(err)
+
+	s.Equal(2, d.Len())
+}
+
+func (s *DatasetTestSuite) TestNullValues() {
+	d, err := newTestTypedDataset()
+	s.NoError(err)
+
+	r := NewRow("John", "1", "", "true", "", "")
+	r.SetNull(2)
+	s.NoError(d.Append(r))
+
+	r = NewRow("John", "1", "", "true", "", "")
+	r.SetNull(1)
+	err = d.Append(r)
+	s.Equal(ErrNullValue{key: "age"}, err)
+	s.Equal("Null value in column age which is not nullable.", err.Error())
+
+	r = NewRow("", "1", "", "true", "", "")
+	r.SetNull(0)
+	s.Error(d.Append(r))
+
+	d, err = newTestNullDataset()
+	s.NoError(err)
+	s.Equal([]string{"Roberts", ""}, d.GetColValues("surname"))
+}
+
 func (s *DatasetTestSuite) TestWriteEmptyDataset() {
 	d := NewDataSet()
 	d.AddHeader("name", "Name")
@@ -202,6 +394,27 @@ func (s *DatasetTestSuite) TestWriteEmptyDataset() {
 	s.Equal(err, ErrEmptyDataset)
 }
 
+func (s *DatasetTestSuite) TestLoadDataset() {
+	d := NewDataSet()
+	mr := &mockReader{d}
+
+	loaded, err := LoadDataset(mr, nil)
+	s.NoError(err)
+	s.Equal(d, loaded)
+}
+
+func (s *DatasetTestSuite) TestLoadConvert() {
+	in := "name,surname\nJulia,Roberts\n"
+	csvr := NewCSVReader(&CSVReaderOpts{HasHeaders: true})
+
+	d, err := LoadDataset(csvr, strings.NewReader(in))
+	s.NoError(err)
+
+	out, err := newTestWrite(d, NewJSONWriter(&JSONOpts{}))
+	s.NoError(err)
+	s.Equal(`[{"name":"Julia","surname":"Roberts"}]`, out)
+}
+
 func TestDatasetTestSuite(t *testing.T) {
 	suite.Run(t, new(DatasetTestSuite))
 }
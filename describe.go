@@ -0,0 +1,199 @@
+package tabular
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var describeHeaders = []struct {
+	key   string
+	title string
+}{
+	{"column", "Column"},
+	{"count", "Count"},
+	{"nulls", "Nulls"},
+	{"distinct", "Distinct"},
+	{"min", "Min"},
+	{"max", "Max"},
+	{"mean", "Mean"},
+	{"std", "Std"},
+	{"p25", "25%"},
+	{"median", "Median"},
+	{"p75", "75%"},
+	{"top", "Top"},
+	{"freq", "Freq"},
+}
+
+// Describe returns new dataset with one row of statistics per column. Null
+// and empty values are counted as nulls and left out of other statistics.
+// Mean, standard deviation and percentiles are computed for numeric columns,
+// the most frequent value and its frequency for other columns, statistics
+// which do not apply are null.
+func (d *Dataset) Describe() (*Dataset, error) {
+	if !d.HasHeaders() {
+		return nil, ErrNoHeaders
+	}
+
+	res := NewDataSet()
+	for _, hdr := range describeHeaders {
+		res.AddHeader(hdr.key, hdr.title)
+	}
+
+	for idx, hdr := range d.Headers() {
+		var values []string
+		for _, row := range d.rows {
+			if val := row.Get(idx); val != "" && !d.isNull(row, idx) {
+				values = append(values, val)
+			}
+		}
+
+		stats := newColumnStats(hdr, values)
+		stats.nulls = d.Len() - len(values)
+		if err := res.Append(stats.row()); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+type columnStats struct {
+	key      string
+	count    int
+	nulls    int
+	distinct int
+
+	min string
+	max string
+
+	// numeric columns
+	numeric bool
+	floats  []float64
+
+	// other columns
+	top  string
+	freq int
+}
+
+func newColumnStats(hdr *Header, values []string) *columnStats {
+	s := &columnStats{
+		key:   hdr.Key,
+		count: len(values),
+	}
+
+	counts := make(map[string]int)
+	for _, val := range values {
+		counts[val]++
+		if counts[val] > s.freq {
+			s.top, s.freq = val, counts[val]
+		}
+	}
+	s.distinct = len(counts)
+
+	if floats, ok := parseFloats(hdr.Type, values); ok {
+		s.numeric = true
+		s.floats = floats
+		sort.Float64s(s.floats)
+		return s
+	}
+
+	typ := hdr.Type
+	if typ == TypeAny {
+		typ = TypeString
+	}
+	for i, val := range values {
+		if i == 0 || compareValues(typ, val, s.min) < 0 {
+			s.min = val
+		}
+		if i == 0 || compareValues(typ, val, s.max) > 0 {
+			s.max = val
+		}
+	}
+	return s
+}
+
+// parseFloats parses values of numeric columns, untyped columns are numeric
+// when all values are numbers.
+func parseFloats(typ ColumnType, values []string) ([]float64, bool) {
+	if len(values) == 0 || (typ != TypeAny && !typ.IsNumeric()) {
+		return nil, false
+	}
+
+	floats := make([]float64, 0, len(values))
+	for _, val := range values {
+		f, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		if err != nil {
+			return nil, false
+		}
+		floats = append(floats, f)
+	}
+	return floats, true
+}
+
+func (s *columnStats) row() *Row {
+	r := NewRow(
+		s.key,
+		strconv.Itoa(s.count),
+		strconv.Itoa(s.nulls),
+		strconv.Itoa(s.distinct),
+	)
+
+	switch {
+	case s.numeric:
+		n := len(s.floats)
+		r.Add(formatFloat(s.floats[0]), formatFloat(s.floats[n-1]))
+		r.Add(formatFloat(s.mean()))
+		if n > 1 {
+			r.Add(formatFloat(s.std()))
+		} else {
+			r.AddNull()
+		}
+		r.Add(
+			formatFloat(s.percentile(0.25)),
+			formatFloat(s.percentile(0.5)),
+			formatFloat(s.percentile(0.75)),
+		)
+		addNulls(r, 2)
+	case s.count > 0:
+		r.Add(s.min, s.max)
+		addNulls(r, 5)
+		r.Add(s.top, strconv.Itoa(s.freq))
+	default:
+		addNulls(r, 9)
+	}
+	return r
+}
+
+func (s *columnStats) mean() float64 {
+	var sum float64
+	for _, f := range s.floats {
+		sum += f
+	}
+	return sum / float64(len(s.floats))
+}
+
+// std returns sample standard deviation.
+func (s *columnStats) std() float64 {
+	mean := s.mean()
+	var sum float64
+	for _, f := range s.floats {
+		sum += (f - mean) * (f - mean)
+	}
+	return math.Sqrt(sum / float64(len(s.floats)-1))
+}
+
+// percentile returns p-th percentile of sorted values using linear
+// interpolation between closest ranks.
+func (s *columnStats) percentile(p float64) float64 {
+	pos := p * float64(len(s.floats)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	return s.floats[lo] + (s.floats[hi]-s.floats[lo])*(pos-float64(lo))
+}
+
+func addNulls(r *Row, n int) {
+	for i := 0; i < n; i++ {
+		r.AddNull()
+	}
+}
@@ -0,0 +1,80 @@
+package tabular
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DescribeTestSuite struct {
+	suite.Suite
+}
+
+func (s *DescribeTestSuite) TestDescribe() {
+	d := NewDataSet()
+	d.AddHeader("name", "Name")
+	d.AddHeader("age", "Age")
+	d.AddTypedHeader("born", "Born", TypeTime, true)
+	d.AddHeader("empty", "Empty")
+
+	s.NoError(d.Append(
+		NewRow("Julia", "40", "1967-10-28", ""),
+		NewRow("John", "42", "1953-12-09", ""),
+		NewRow("Julia", "", "", ""),
+		NewRow("Bill", "70", "1950-09-21", ""),
+	))
+	r := NewRow("Anna", "10", "", "")
+	r.SetNull(2)
+	s.NoError(d.Append(r))
+
+	res, err := d.Describe()
+	s.NoError(err)
+	s.Equal(13, res.HeaderCount())
+	s.Equal(4, res.Len())
+	s.Equal([]string{"name", "age", "born", "empty"}, res.GetColValues("column"))
+
+	name, _ := res.Get(0)
+	s.Equal([]string{"name", "5", "0", "4", "Anna", "Julia", "", "", "", "", "", "Julia", "2"}, name.Items())
+	s.True(name.IsNull(6))
+	s.False(name.IsNull(11))
+
+	age, _ := res.Get(1)
+	s.Equal([]string{"age", "4", "1", "4", "10", "70", "40.5", "24.515301344262525", "32.5", "41", "49", "", ""}, age.Items())
+	s.True(age.IsNull(12))
+
+	born, _ := res.Get(2)
+	s.Equal([]string{"born", "3", "2", "3", "1950-09-21", "1967-10-28"}, born.Items()[:6])
+
+	empty, _ := res.Get(3)
+	s.Equal([]string{"empty", "0", "5", "0"}, empty.Items()[:4])
+	s.True(empty.IsNull(4))
+	s.True(empty.IsNull(12))
+
+	out, err := newTestWrite(res, NewJSONWriter(&JSONOpts{}))
+	s.NoError(err)
+	s.Contains(out, `{"column":"empty","count":"0","nulls":"5","distinct":"0","min":null,`)
+}
+
+func (s *DescribeTestSuite) TestDescribeSingleValue() {
+	d := NewDataSet()
+	d.AddTypedHeader("score", "Score", TypeFloat, false)
+	s.NoError(d.Append(NewRow("1.5")))
+
+	res, err := d.Describe()
+	s.NoError(err)
+	row, _ := res.Get(0)
+	s.Equal([]string{"score", "1", "0", "1", "1.5", "1.5", "1.5", "", "1.5", "1.5", "1.5", "", ""}, row.Items())
+	s.True(row.IsNull(7))
+}
+
+func (s *DescribeTestSuite) TestDescribeWithoutHeaders() {
+	d := NewDataSet()
+	s.NoError(d.Append(NewRow("a")))
+
+	_, err := d.Describe()
+	s.Equal(ErrNoHeaders, err)
+}
+
+func TestDescribeTestSuite(t *testing.T) {
+	suite.Run(t, new(DescribeTestSuite))
+}
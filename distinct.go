@@ -0,0 +1,93 @@
+package tabular
+
+// KeepPolicy controls which of duplicate rows is kept.
+type KeepPolicy int
+
+const (
+	// KeepFirst keeps the first of duplicate rows.
+	KeepFirst KeepPolicy = iota
+
+	// KeepLast keeps the last of duplicate rows.
+	KeepLast
+)
+
+// DuplicatesCountKey is key of the count column of Duplicates report.
+const DuplicatesCountKey = "count"
+
+// Distinct returns new dataset without duplicate rows, first of duplicate
+// rows is kept. Null values are distinct from empty values.
+func (d *Dataset) Distinct() *Dataset {
+	return d.distinct(KeepFirst, sequence(d.cols))
+}
+
+// DistinctBy returns new dataset with rows having distinct values of keys,
+// all columns are compared when no keys are given. Kept rows stay in their
+// original order.
+func (d *Dataset) DistinctBy(keep KeepPolicy, keys ...string) (*Dataset, error) {
+	if len(keys) == 0 {
+		return d.distinct(keep, sequence(d.cols)), nil
+	}
+	idxs, err := d.columnIndexesOf(keys)
+	if err != nil {
+		return nil, err
+	}
+	return d.distinct(keep, idxs), nil
+}
+
+// Duplicates returns report dataset with values of keys occurring more than
+// once followed by count column, all columns are compared when no keys are
+// given. Groups are ordered by first occurrence. ErrNoHeaders is returned
+// for dataset without headers.
+func (d *Dataset) Duplicates(keys ...string) (*Dataset, error) {
+	if !d.HasHeaders() {
+		return nil, ErrNoHeaders
+	}
+	if len(keys) == 0 {
+		for _, hdr := range d.Headers() {
+			keys = append(keys, hdr.Key)
+		}
+	}
+
+	g, err := d.GroupBy(keys...)
+	if err != nil {
+		return nil, err
+	}
+	res, err := g.Aggregate(Aggregation{
+		Key:   DuplicatesCountKey,
+		Title: "Count",
+		Func:  AggCount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Filter(func(r *Row) bool {
+		return r.Get(len(keys)) != "1"
+	}), nil
+}
+
+func (d *Dataset) distinct(keep KeepPolicy, idxs []int) *Dataset {
+	kept := make(map[string]int)
+	var rows []*Row
+	for _, row := range d.rows {
+		key := d.groupKey(row, idxs)
+		pos, ok := kept[key]
+		switch {
+		case !ok:
+			kept[key] = len(rows)
+			rows = append(rows, row)
+		case keep == KeepLast:
+			rows[pos] = nil
+			kept[key] = len(rows)
+			rows = append(rows, row)
+		}
+	}
+
+	res := rows[:0]
+	for _, row := range rows {
+		if row != nil {
+			res = append(res, row)
+		}
+	}
+	return d.derive(res)
+}
@@ -0,0 +1,124 @@
+package tabular
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DistinctTestSuite struct {
+	suite.Suite
+}
+
+func (s *DistinctTestSuite) newDataset() *Dataset {
+	d := NewDataSet()
+	d.AddHeader("email", "Email")
+	d.AddHeader("name", "Name")
+	d.AddHeader("source", "Source")
+
+	r1 := NewRow("julia@example.com", "Julia", "crm")
+	r1.AddTag("first")
+	r5 := NewRow("john@example.com", "", "crm")
+	r5.SetNull(1)
+	r6 := NewRow("julia@example.com", "Julia R.", "billing")
+	r6.AddTag("last")
+	s.Require().NoError(d.Append(
+		r1,
+		NewRow("john@example.com", "John", "crm"),
+		NewRow("julia@example.com", "Julia", "crm"),
+		NewRow("john@example.com", "", "crm"),
+		r5,
+		r6,
+	))
+	return d
+}
+
+func (s *DistinctTestSuite) TestDistinct() {
+	d := s.newDataset()
+
+	res := d.Distinct()
+	s.Equal([]string{"Julia", "John", "", "", "Julia R."}, res.GetColValues("name"))
+	s.Equal(6, d.Len())
+
+	row, _ := res.Get(0)
+	s.True(row.HasTag("first"))
+	row, _ = res.Get(3)
+	s.True(row.IsNull(1))
+
+	headerless := NewDataSet()
+	s.NoError(headerless.Append(NewRow("a", "b"), NewRow("a", "b"), NewRow("a", "c")))
+	s.Equal(2, headerless.Distinct().Len())
+}
+
+func (s *DistinctTestSuite) TestDistinctBy() {
+	d := s.newDataset()
+
+	res, err := d.DistinctBy(KeepFirst, "email")
+	s.NoError(err)
+	s.Equal([]string{"Julia", "John"}, res.GetColValues("name"))
+
+	res, err = d.DistinctBy(KeepLast, "email")
+	s.NoError(err)
+	s.Equal([]string{"", "Julia R."}, res.GetColValues("name"))
+	row, _ := res.Get(1)
+	s.True(row.HasTag("last"))
+
+	res, err = d.DistinctBy(KeepLast, "email", "source")
+	s.NoError(err)
+	s.Equal([]string{"julia@example.com", "john@example.com", "julia@example.com"}, res.GetColValues("email"))
+	s.Equal([]string{"Julia", "", "Julia R."}, res.GetColValues("name"))
+
+	res, err = d.DistinctBy(KeepLast)
+	s.NoError(err)
+	s.Equal([]string{"John", "Julia", "", "", "Julia R."}, res.GetColValues("name"))
+
+	_, err = d.DistinctBy(KeepFirst, "missing")
+	s.Equal(ErrColumnNotFound{"missing"}, err)
+}
+
+func (s *DistinctTestSuite) TestDuplicatesNoHeaders() {
+	d := NewDataSet()
+	s.NoError(d.Append(NewRow("a"), NewRow("b")))
+
+	_, err := d.Duplicates()
+	s.Equal(ErrNoHeaders, err)
+}
+
+func (s *DistinctTestSuite) TestDuplicates() {
+	d := s.newDataset()
+
+	res, err := d.Duplicates("email")
+	s.NoError(err)
+	s.Equal(2, res.HeaderCount())
+	s.Equal([]string{"julia@example.com", "john@example.com"}, res.GetColValues("email"))
+	s.Equal([]string{"3", "3"}, res.GetColValues(DuplicatesCountKey))
+
+	res, err = d.Duplicates()
+	s.NoError(err)
+	s.Equal(4, res.HeaderCount())
+	s.Equal([]string{"Julia"}, res.GetColValues("name"))
+	s.Equal([]string{"2"}, res.GetColValues(DuplicatesCountKey))
+
+	res, err = d.Duplicates("source")
+	s.NoError(err)
+	s.Equal([]string{"crm"}, res.GetColValues("source"))
+	s.Equal([]string{"5"}, res.GetColValues(DuplicatesCountKey))
+
+	res, err = d.Duplicates("email", "source", "name")
+	s.NoError(err)
+	s.Equal(1, res.Len())
+
+	unique, err := d.DistinctBy(KeepFirst, "email")
+	s.NoError(err)
+	res, err = unique.Duplicates("email")
+	s.NoError(err)
+	s.Equal(0, res.Len())
+
+	s.NoError(d.RenameColumn("source", DuplicatesCountKey))
+	_, err = d.Duplicates()
+	s.Equal(ErrDuplicateColumn{DuplicatesCountKey}, err)
+}
+
+func TestDistinctTestSuite(t *testing.T) {
+	suite.Run(t, new(DistinctTestSuite))
+}
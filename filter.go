@@ -0,0 +1,335 @@
+package tabular
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Operator represents comparison operator used by Where.
+type Operator int
+
+const (
+	// OpEq matches values equal to the operand.
+	OpEq Operator = iota
+
+	// OpNe matches values not equal to the operand.
+	OpNe
+
+	// OpLt matches values less than the operand.
+	OpLt
+
+	// OpLe matches values less than or equal to the operand.
+	OpLe
+
+	// OpGt matches values greater than the operand.
+	OpGt
+
+	// OpGe matches values greater than or equal to the operand.
+	OpGe
+
+	// OpContains matches values containing the operand.
+	OpContains
+
+	// OpPrefix matches values starting with the operand.
+	OpPrefix
+
+	// OpSuffix matches values ending with the operand.
+	OpSuffix
+
+	// OpMatch matches values matching the operand regular expression.
+	OpMatch
+
+	// OpIn matches values equal to any of the operands.
+	OpIn
+)
+
+var operatorNames = map[Operator]string{
+	OpEq:       "eq",
+	OpNe:       "ne",
+	OpLt:       "lt",
+	OpLe:       "le",
+	OpGt:       "gt",
+	OpGe:       "ge",
+	OpContains: "contains",
+	OpPrefix:   "prefix",
+	OpSuffix:   "suffix",
+	OpMatch:    "match",
+	OpIn:       "in",
+}
+
+// String returns name of the operator.
+func (o Operator) String() string {
+	if name, ok := operatorNames[o]; ok {
+		return name
+	}
+	return "Operator(" + strconv.Itoa(int(o)) + ")"
+}
+
+// ErrColumnNotFound is error returned when referencing column which does not exist.
+type ErrColumnNotFound struct {
+	key string
+}
+
+func (e ErrColumnNotFound) Error() string {
+	return fmt.Sprintf("Column %s not found.", e.key)
+}
+
+// ErrInvalidOperands is error returned when operator gets wrong number of operands.
+type ErrInvalidOperands struct {
+	op    Operator
+	count int
+}
+
+func (e ErrInvalidOperands) Error() string {
+	return fmt.Sprintf("Operator %s needs exactly one value, got %d.", e.op, e.count)
+}
+
+// Filter returns new dataset with rows for which fn returns true.
+func (d *Dataset) Filter(fn func(*Row) bool) *Dataset {
+	var rows []*Row
+	for _, row := range d.rows {
+		if fn(row) {
+			rows = append(rows, row)
+		}
+	}
+	return d.derive(rows)
+}
+
+// Where returns new dataset with rows whose value of column key matches
+// values using operator op. Values of typed columns are compared as native
+// values. Untyped values are equal only when they are the same strings, they
+// are ordered as numbers when both sides are numeric and as strings
+// otherwise. Null values never match.
+func (d *Dataset) Where(key string, op Operator, values ...string) (*Dataset, error) {
+	idx, ok := d.getColumnIndex(key)
+	if !ok {
+		return nil, ErrColumnNotFound{key}
+	}
+	hdr, _ := d.GetHeader(idx)
+
+	pred, err := newPredicate(hdr, op, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Filter(func(r *Row) bool {
+		if hdr.isNull(r, idx) {
+			return false
+		}
+		return pred(r.Get(idx))
+	}), nil
+}
+
+func newPredicate(hdr *Header, op Operator, values []string) (func(string) bool, error) {
+	if op == OpIn {
+		return func(val string) bool {
+			for _, v := range values {
+				if equalValues(hdr.Type, val, v) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+
+	if _, ok := operatorNames[op]; !ok || len(values) != 1 {
+		return nil, ErrInvalidOperands{
+			op:    op,
+			count: len(values),
+		}
+	}
+	operand := values[0]
+
+	switch op {
+	case OpEq:
+		return func(val string) bool { return equalValues(hdr.Type, val, operand) }, nil
+	case OpNe:
+		return func(val string) bool { return !equalValues(hdr.Type, val, operand) }, nil
+	case OpLt:
+		return func(val string) bool { return compareValues(hdr.Type, val, operand) < 0 }, nil
+	case OpLe:
+		return func(val string) bool { return compareValues(hdr.Type, val, operand) <= 0 }, nil
+	case OpGt:
+		return func(val string) bool { return compareValues(hdr.Type, val, operand) > 0 }, nil
+	case OpGe:
+		return func(val string) bool { return compareValues(hdr.Type, val, operand) >= 0 }, nil
+	case OpContains:
+		return func(val string) bool { return strings.Contains(val, operand) }, nil
+	case OpPrefix:
+		return func(val string) bool { return strings.HasPrefix(val, operand) }, nil
+	case OpSuffix:
+		return func(val string) bool { return strings.HasSuffix(val, operand) }, nil
+	default:
+		re, err := regexp.Compile(operand)
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString, nil
+	}
+}
+
+// equalValues checks equality of a and b, values of typed columns are
+// compared in their canonical form. Values of untyped and string columns
+// are compared as they are, like when grouping or joining.
+func equalValues(typ ColumnType, a string, b string) bool {
+	if typ == TypeAny || typ == TypeString {
+		return a == b
+	}
+	return compareValues(typ, a, b) == 0
+}
+
+// compareValues compares a and b as native values of the column type,
+// untyped values are compared as numbers. Strings are compared when values
+// can not be parsed.
+func compareValues(typ ColumnType, a string, b string) int {
+	switch typ {
+	case TypeAny:
+		if res, ok := compareNumbers(a, b); ok {
+			return res
+		}
+	case TypeDecimal:
+		x, errx := parseDecimal(a)
+		y, erry := parseDecimal(b)
+		if errx == nil && erry == nil {
+			return compareDecimals(x, y)
+		}
+	case TypeInt:
+		x, errx := strconv.ParseInt(a, 10, 64)
+		y, erry := strconv.ParseInt(b, 10, 64)
+		if errx == nil && erry == nil {
+			return compareInts(x, y)
+		}
+		if res, ok := compareNumbers(a, b); ok {
+			return res
+		}
+	case TypeFloat:
+		x, errx := strconv.ParseFloat(a, 64)
+		y, erry := strconv.ParseFloat(b, 64)
+		if errx == nil && erry == nil {
+			return compareFloats(x, y)
+		}
+	case TypeBool:
+		x, errx := strconv.ParseBool(a)
+		y, erry := strconv.ParseBool(b)
+		if errx == nil && erry == nil {
+			return compareBools(x, y)
+		}
+	case TypeTime:
+		x, errx := parseTime(a)
+		y, erry := parseTime(b)
+		if errx == nil && erry == nil {
+			return compareTimes(x, y)
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// compareNumbers compares a and b as numbers, plain decimals are compared
+// without losing precision. False is returned when values are not numbers.
+func compareNumbers(a string, b string) (int, bool) {
+	x, errx := parseDecimal(a)
+	y, erry := parseDecimal(b)
+	if errx == nil && erry == nil {
+		return compareDecimals(x, y), true
+	}
+
+	fx, errx := strconv.ParseFloat(a, 64)
+	fy, erry := strconv.ParseFloat(b, 64)
+	if errx == nil && erry == nil {
+		return compareFloats(fx, fy), true
+	}
+	return 0, false
+}
+
+func compareInts(x int64, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func compareFloats(x float64, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// compareDecimals compares decimals in canonical form without losing precision.
+func compareDecimals(x string, y string) int {
+	negx, negy := strings.HasPrefix(x, "-"), strings.HasPrefix(y, "-")
+	if negx != negy {
+		if isZeroDecimal(x) && isZeroDecimal(y) {
+			return 0
+		}
+		if negx {
+			return -1
+		}
+		return 1
+	}
+
+	res := compareAbsDecimals(strings.TrimPrefix(x, "-"), strings.TrimPrefix(y, "-"))
+	if negx {
+		return -res
+	}
+	return res
+}
+
+func compareAbsDecimals(x string, y string) int {
+	xi, xf := splitDecimal(x)
+	yi, yf := splitDecimal(y)
+	if len(xi) != len(yi) {
+		return compareFloats(float64(len(xi)), float64(len(yi)))
+	}
+	if res := strings.Compare(xi, yi); res != 0 {
+		return res
+	}
+
+	for len(xf) < len(yf) {
+		xf += "0"
+	}
+	for len(yf) < len(xf) {
+		yf += "0"
+	}
+	return strings.Compare(xf, yf)
+}
+
+func splitDecimal(s string) (string, string) {
+	if idx := strings.IndexByte(s, '.'); idx >= 0 {
+		return s[:idx], s[idx+1:]
+	}
+	return s, ""
+}
+
+func isZeroDecimal(s string) bool {
+	return strings.Trim(s, "-0.") == ""
+}
+
+func compareBools(x bool, y bool) int {
+	switch {
+	case x == y:
+		return 0
+	case y:
+		return -1
+	}
+	return 1
+}
+
+func compareTimes(x time.Time, y time.Time) int {
+	switch {
+	case x.Before(y):
+		return -1
+	case x.After(y):
+		return 1
+	}
+	return 0
+}
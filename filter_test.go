@@ -0,0 +1,176 @@
+package tabular
+
+import (
+	"regexp/syntax"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FilterTestSuite struct {
+	suite.Suite
+}
+
+func (s *FilterTestSuite) TestFilter() {
+	d, err := newTestDataset()
+	s.NoError(err)
+
+	f := d.Filter(func(r *Row) bool {
+		return r.Get(0) == "John"
+	})
+	s.Equal(1, f.Len())
+	s.Equal(2, d.Len())
+	s.Equal(9, f.lengths[1])
+	s.Equal(7, d.Filter(func(r *Row) bool { return r.Get(0) == "Julia" }).lengths[1])
+}
+
+func (s *FilterTestSuite) TestWhere() {
+	d := NewDataSet()
+	d.AddHeader("name", "Name")
+	d.AddHeader("age", "Age")
+	s.NoError(d.Append(
+		NewRow("Julia", "40"),
+		NewRow("John", "9"),
+		NewRow("Johanna", "100"),
+	))
+	r := NewRow("Bill", "")
+	r.SetNull(1)
+	s.NoError(d.Append(r))
+
+	cases := []struct {
+		key      string
+		op       Operator
+		values   []string
+		expected []string
+	}{
+		{"name", OpEq, []string{"John"}, []string{"John"}},
+		{"name", OpNe, []string{"John"}, []string{"Julia", "Johanna", "Bill"}},
+		{"age", OpNe, []string{"40"}, []string{"John", "Johanna"}},
+		{"age", OpLt, []string{"40"}, []string{"John"}},
+		{"age", OpLe, []string{"40"}, []string{"Julia", "John"}},
+		{"age", OpGt, []string{"40"}, []string{"Johanna"}},
+		{"age", OpGe, []string{"40"}, []string{"Julia", "Johanna"}},
+		{"name", OpGt, []string{"John"}, []string{"Julia"}},
+		{"name", OpContains, []string{"ann"}, []string{"Johanna"}},
+		{"name", OpPrefix, []string{"Jo"}, []string{"John", "Johanna"}},
+		{"name", OpSuffix, []string{"a"}, []string{"Julia", "Johanna"}},
+		{"name", OpMatch, []string{"^J.h"}, []string{"John", "Johanna"}},
+		{"name", OpIn, []string{"Bill", "Julia"}, []string{"Julia", "Bill"}},
+		{"name", OpIn, nil, nil},
+	}
+	for _, c := range cases {
+		res, err := d.Where(c.key, c.op, c.values...)
+		s.NoError(err)
+		s.Equal(c.expected, res.GetColValues("name"), c.op.String())
+	}
+	s.Equal(4, d.Len())
+}
+
+func (s *FilterTestSuite) TestWhereTyped() {
+	d, err := newTestTypedDataset()
+	s.NoError(err)
+
+	res, err := d.Where("age", OpEq, "+40")
+	s.NoError(err)
+	s.Equal([]string{"Julia"}, res.GetColValues("name"))
+
+	res, err = d.Where("active", OpEq, "false")
+	s.NoError(err)
+	s.Equal([]string{"007"}, res.GetColValues("name"))
+
+	res, err = d.Where("born", OpLt, "1970-01-01T00:00:00Z")
+	s.NoError(err)
+	s.Equal([]string{"Julia"}, res.GetColValues("name"))
+
+	res, err = d.Where("salary", OpGt, "1200.4999999999999999999")
+	s.NoError(err)
+	s.Equal([]string{"Julia"}, res.GetColValues("name"))
+
+	res, err = d.Where("salary", OpIn, "1200.5", "10")
+	s.NoError(err)
+	s.Equal([]string{"Julia"}, res.GetColValues("name"))
+}
+
+func (s *FilterTestSuite) TestWhereNumbers() {
+	d := NewDataSet()
+	d.AddTypedHeader("id", "ID", TypeInt, false)
+	d.AddHeader("value", "Value")
+	s.NoError(d.Append(
+		NewRow("9007199254740992", "1.0"),
+		NewRow("9007199254740993", "1e0"),
+		NewRow("1", "12345678901234567891"),
+	))
+
+	res, err := d.Where("id", OpEq, "9007199254740992")
+	s.NoError(err)
+	s.Equal([]string{"9007199254740992"}, res.GetColValues("id"))
+
+	res, err = d.Where("id", OpGt, "9007199254740992")
+	s.NoError(err)
+	s.Equal([]string{"9007199254740993"}, res.GetColValues("id"))
+
+	for _, op := range []Operator{OpEq, OpIn} {
+		res, err = d.Where("value", op, "1")
+		s.NoError(err)
+		s.Equal(0, res.Len(), op.String())
+
+		res, err = d.Where("value", op, "1.0")
+		s.NoError(err)
+		s.Equal([]string{"1.0"}, res.GetColValues("value"), op.String())
+	}
+
+	zips := NewDataSet()
+	zips.AddHeader("zip", "ZIP")
+	s.NoError(zips.Append(NewRow("01234"), NewRow("1234"), NewRow("1234.0")))
+	res, err = zips.Where("zip", OpEq, "01234")
+	s.NoError(err)
+	s.Equal([]string{"01234"}, res.GetColValues("zip"))
+
+	res, err = d.Where("value", OpLe, "1")
+	s.NoError(err)
+	s.Equal([]string{"1.0", "1e0"}, res.GetColValues("value"))
+
+	res, err = d.Where("value", OpGe, "1")
+	s.NoError(err)
+	s.Equal([]string{"1.0", "1e0", "12345678901234567891"}, res.GetColValues("value"))
+
+	res, err = d.Where("value", OpNe, "1.0")
+	s.NoError(err)
+	s.Equal([]string{"1e0", "12345678901234567891"}, res.GetColValues("value"))
+
+	res, err = d.Where("value", OpEq, "12345678901234567890")
+	s.NoError(err)
+	s.Equal(0, res.Len())
+}
+
+func (s *FilterTestSuite) TestWhereErrors() {
+	d, err := newTestDataset()
+	s.NoError(err)
+
+	_, err = d.Where("missing", OpEq, "x")
+	s.Equal(ErrColumnNotFound{"missing"}, err)
+	s.Equal("Column missing not found.", err.Error())
+
+	_, err = d.Where("name", OpEq)
+	s.Equal(ErrInvalidOperands{op: OpEq, count: 0}, err)
+	s.Equal("Operator eq needs exactly one value, got 0.", err.Error())
+
+	_, err = d.Where("name", Operator(42), "x")
+	s.Equal(ErrInvalidOperands{op: Operator(42), count: 1}, err)
+
+	_, err = d.Where("name", OpMatch, "(")
+	s.IsType(&syntax.Error{}, err)
+}
+
+func (s *FilterTestSuite) TestCompareDecimals() {
+	s.Equal(0, compareDecimals("1.50", "1.5"))
+	s.Equal(0, compareDecimals("-0", "0.0"))
+	s.Equal(-1, compareDecimals("-2", "1"))
+	s.Equal(1, compareDecimals("10", "9.99"))
+	s.Equal(-1, compareDecimals("-10", "-9.99"))
+	s.Equal(1, compareDecimals("12345678901234567890.2", "12345678901234567890.1"))
+}
+
+func TestFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(FilterTestSuite))
+}
@@ -0,0 +1,157 @@
+package tabular
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// FromMaps creates new dataset from maps, keys are used as headers in given
+// order. Sorted union of all map keys is used when keys are empty. Missing
+// keys and nil values are null.
+func FromMaps(maps []map[string]interface{}, keys []string) (*Dataset, error) {
+	if len(keys) == 0 {
+		set := newStringSet()
+		for _, m := range maps {
+			for key := range m {
+				set.Add(key)
+			}
+		}
+		keys = set.Items()
+		sort.Strings(keys)
+	}
+
+	d := NewDataSet()
+	for _, key := range keys {
+		d.AddHeader(key, key)
+	}
+
+	for _, m := range maps {
+		row := NewRow()
+		for _, key := range keys {
+			if err := addValue(row, key, m[key]); err != nil {
+				return nil, err
+			}
+		}
+		if err := d.Append(row); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// FromSQLRows creates new dataset from query result, column names are used
+// as headers. NULL values are null, byte slices are converted to strings and
+// times are formatted using RFC 3339 layout. Rows are read until exhausted,
+// closing them stays with the caller.
+func FromSQLRows(rows *sql.Rows) (*Dataset, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	d := NewDataSet()
+	for _, col := range cols {
+		d.AddHeader(col, col)
+	}
+
+	vals := make([]interface{}, len(cols))
+	dest := make([]interface{}, len(cols))
+	for idx := range vals {
+		dest[idx] = &vals[idx]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		row := NewRow()
+		for idx, val := range vals {
+			if err := addValue(row, cols[idx], val); err != nil {
+				return nil, err
+			}
+		}
+		if err := d.Append(row); err != nil {
+			return nil, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// addValue adds string representation of val to the row, nil is added as null.
+func addValue(row *Row, key string, val interface{}) error {
+	s, null, err := stringifyValue(key, val)
+	if err != nil {
+		return err
+	}
+	if null {
+		row.AddNull()
+	} else {
+		row.Add(s)
+	}
+	return nil
+}
+
+// stringifyValue converts val to string, true is returned for null values.
+func stringifyValue(key string, val interface{}) (string, bool, error) {
+	if rv := reflect.ValueOf(val); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return "", true, nil
+	}
+
+	switch v := val.(type) {
+	case nil:
+		return "", true, nil
+	case string:
+		return v, false, nil
+	case []byte:
+		return string(v), false, nil
+	case sql.RawBytes:
+		return string(v), false, nil
+	case bool:
+		return strconv.FormatBool(v), false, nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), false, nil
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return "", false, err
+		}
+		return stringifyValue(key, dv)
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		if err != nil {
+			return "", false, err
+		}
+		return string(text), false, nil
+	case fmt.Stringer:
+		return v.String(), false, nil
+	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		return stringifyValue(key, rv.Elem().Interface())
+	case reflect.String:
+		return rv.String(), false, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), false, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), false, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits()), false, nil
+	}
+
+	return "", false, ErrUnsupportedField{
+		field: key,
+		typ:   rv.Type(),
+	}
+}
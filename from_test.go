@@ -0,0 +1,129 @@
+package tabular
+
+import (
+	"database/sql"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FromTestSuite struct {
+	suite.Suite
+}
+
+func (s *FromTestSuite) TestFromMaps() {
+	age := 40
+	maps := []map[string]interface{}{
+		{"name": "Julia", "age": &age, "score": 9.5, "active": true},
+		{"name": "John", "born": time.Date(1953, 12, 9, 0, 0, 0, 0, time.UTC), "ip": net.IPv4(10, 0, 0, 1)},
+		{"name": sql.NullString{}, "age": uint8(42), "active": nil, "raw": []byte("x")},
+	}
+
+	d, err := FromMaps(maps, nil)
+	s.NoError(err)
+
+	var keys []string
+	for _, hdr := range d.Headers() {
+		keys = append(keys, hdr.Key)
+	}
+	s.Equal([]string{"active", "age", "born", "ip", "name", "raw", "score"}, keys)
+
+	r1, _ := d.Get(0)
+	r2, _ := d.Get(1)
+	r3, _ := d.Get(2)
+	s.Equal([]string{"true", "40", "", "", "Julia", "", "9.5"}, r1.Items())
+	s.Equal([]string{"", "", "1953-12-09T00:00:00Z", "10.0.0.1", "John", "", ""}, r2.Items())
+	s.Equal([]string{"", "42", "", "", "", "x", ""}, r3.Items())
+	s.True(r1.IsNull(2))
+	s.True(r3.IsNull(0))
+	s.True(r3.IsNull(4))
+
+	d, err = FromMaps(maps, []string{"name", "age"})
+	s.NoError(err)
+	s.Equal(2, d.HeaderCount())
+	s.Equal([]string{"Julia", "John", ""}, d.GetColValues("name"))
+}
+
+func (s *FromTestSuite) TestFromMapsNilPointers() {
+	maps := []map[string]interface{}{
+		{"t": (*time.Time)(nil), "s": (*sql.NullString)(nil)},
+	}
+
+	d, err := FromMaps(maps, nil)
+	s.NoError(err)
+
+	r, _ := d.Get(0)
+	s.Equal([]string{"", ""}, r.Items())
+	s.True(r.IsNull(0))
+	s.True(r.IsNull(1))
+}
+
+func (s *FromTestSuite) TestFromMapsErrors() {
+	_, err := FromMaps([]map[string]interface{}{{"tags": []string{"a"}}}, nil)
+	s.Equal("Unsupported type []string of field tags.", err.Error())
+
+	_, err = FromMaps([]map[string]interface{}{{"bad": testBadMarshaler{}}}, nil)
+	s.EqualError(err, "bad")
+}
+
+func (s *FromTestSuite) TestFromSQLRows() {
+	db, mock, err := sqlmock.New()
+	s.NoError(err)
+	defer db.Close()
+
+	born := time.Date(1967, 10, 28, 10, 30, 0, 0, time.UTC)
+	mock.ExpectQuery("SELECT").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "name", "score", "born", "active"}).
+			AddRow(int64(1), []byte("Julia"), 9.5, born, true).
+			AddRow(int64(2), []byte(""), nil, nil, false),
+	)
+
+	rows, err := db.Query("SELECT id, name, score, born, active FROM actors")
+	s.NoError(err)
+	defer rows.Close()
+
+	d, err := FromSQLRows(rows)
+	s.NoError(err)
+	s.NoError(mock.ExpectationsWereMet())
+
+	r1, _ := d.Get(0)
+	r2, _ := d.Get(1)
+	s.Equal([]string{"1", "Julia", "9.5", "1967-10-28T10:30:00Z", "true"}, r1.Items())
+	s.Equal([]string{"2", "", "", "", "false"}, r2.Items())
+	s.False(r2.IsNull(1))
+	s.True(r2.IsNull(2))
+	s.True(r2.IsNull(3))
+
+	out, err := newTestWrite(d, NewCSVWriter(&CSVOpts{Comma: ',', NullToken: "NULL"}))
+	s.NoError(err)
+	s.Equal("id,name,score,born,active\n1,Julia,9.5,1967-10-28T10:30:00Z,true\n2,,NULL,NULL,false\n", out)
+}
+
+func (s *FromTestSuite) TestFromSQLRowsError() {
+	db, mock, err := sqlmock.New()
+	s.NoError(err)
+	defer db.Close()
+
+	rowErr := errors.New("connection lost")
+	mock.ExpectQuery("SELECT").WillReturnRows(
+		sqlmock.NewRows([]string{"id"}).
+			AddRow(int64(1)).
+			AddRow(int64(2)).
+			RowError(1, rowErr),
+	)
+
+	rows, err := db.Query("SELECT id FROM actors")
+	s.NoError(err)
+	defer rows.Close()
+
+	_, err = FromSQLRows(rows)
+	s.Equal(rowErr, err)
+}
+
+func TestFromTestSuite(t *testing.T) {
+	suite.Run(t, new(FromTestSuite))
+}
@@ -9,5 +9,6 @@ require (
 	github.com/lib/pq v1.3.0 // indirect
 	github.com/mattn/go-sqlite3 v2.0.2+incompatible // indirect
 	github.com/stretchr/testify v1.4.0
+	golang.org/x/text v0.3.2
 	google.golang.org/appengine v1.6.5 // indirect
 )
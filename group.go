@@ -0,0 +1,352 @@
+package tabular
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrAggregate is error returned when aggregator fails for a column.
+type ErrAggregate struct {
+	key string
+	err error
+}
+
+func (e ErrAggregate) Error() string {
+	return fmt.Sprintf("Aggregate %s failed: %v.", e.key, e.err)
+}
+
+// Unwrap returns the underlying error.
+func (e ErrAggregate) Unwrap() error {
+	return e.err
+}
+
+// Aggregator reduces non-null column values of a group to a single value.
+type Aggregator func(values []string) (string, error)
+
+// Aggregation represents an aggregate column computed for every group.
+type Aggregation struct {
+	// Key and Title of the resulting column, Key is used when Title is empty.
+	Key   string
+	Title string
+
+	// Col is the source column, aggregator gets one empty value for every
+	// row of the group when empty.
+	Col string
+
+	Func Aggregator
+}
+
+// Group represents rows sharing the same values of group keys.
+type Group struct {
+	Values  []string
+	Dataset *Dataset
+
+	nulls []bool
+}
+
+// Grouping represents dataset rows split into groups.
+type Grouping struct {
+	d      *Dataset
+	keys   []string
+	idxs   []int
+	groups []*Group
+}
+
+// GroupBy splits rows into groups by values of keys, groups are ordered by
+// first occurrence. Null values form a group distinct from empty values.
+func (d *Dataset) GroupBy(keys ...string) (*Grouping, error) {
+	idxs, err := d.columnIndexesOf(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &Grouping{
+		d:    d,
+		keys: keys,
+		idxs: idxs,
+	}
+
+	var rows [][]*Row
+	index := make(map[string]int)
+	for _, row := range d.rows {
+		key := d.groupKey(row, idxs)
+		pos, ok := index[key]
+		if !ok {
+			pos = len(g.groups)
+			index[key] = pos
+			g.groups = append(g.groups, d.newGroup(row, idxs))
+			rows = append(rows, nil)
+		}
+		rows[pos] = append(rows[pos], row)
+	}
+
+	for pos, group := range g.groups {
+		group.Dataset = d.derive(rows[pos])
+	}
+	return g, nil
+}
+
+// Groups returns a slice of groups.
+func (g *Grouping) Groups() []*Group {
+	return g.groups
+}
+
+// Len returns the group count.
+func (g *Grouping) Len() int {
+	return len(g.groups)
+}
+
+// Aggregate returns new dataset with one row per group, headers are the group
+// keys followed by aggregate columns.
+func (g *Grouping) Aggregate(aggs ...Aggregation) (*Dataset, error) {
+	d := NewDataSet()
+	for _, idx := range g.idxs {
+		hdr, _ := g.d.GetHeader(idx)
+		d.AddTypedHeader(hdr.Key, hdr.Title, hdr.Type, hdr.Nullable)
+	}
+
+	cols := make([]int, 0, len(aggs))
+	for _, agg := range aggs {
+		if d.HasCol(agg.Key) {
+			return nil, ErrDuplicateColumn{agg.Key}
+		}
+
+		col := -1
+		if agg.Col != "" {
+			idx, err := g.d.columnIndex(agg.Col)
+			if err != nil {
+				return nil, err
+			}
+			col = idx
+		}
+		cols = append(cols, col)
+
+		title := agg.Title
+		if title == "" {
+			title = agg.Key
+		}
+		d.AddHeader(agg.Key, title)
+	}
+
+	for _, group := range g.groups {
+		row := NewRow()
+		for idx, val := range group.Values {
+			if group.nulls[idx] {
+				row.AddNull()
+			} else {
+				row.Add(val)
+			}
+		}
+
+		for i, agg := range aggs {
+			val, err := agg.Func(group.values(cols[i]))
+			if err != nil {
+				return nil, ErrAggregate{
+					key: agg.Key,
+					err: err,
+				}
+			}
+			row.Add(val)
+		}
+
+		if err := d.Append(row); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// values returns non-null values of column col, one empty value per row is
+// returned when col is negative.
+func (g *Group) values(col int) []string {
+	d := g.Dataset
+	vals := make([]string, 0, d.Len())
+	for _, row := range d.rows {
+		switch {
+		case col < 0:
+			vals = append(vals, "")
+		case !d.isNull(row, col):
+			vals = append(vals, row.Get(col))
+		}
+	}
+	return vals
+}
+
+func (d *Dataset) newGroup(row *Row, idxs []int) *Group {
+	g := &Group{
+		Values: make([]string, 0, len(idxs)),
+		nulls:  make([]bool, 0, len(idxs)),
+	}
+	for _, idx := range idxs {
+		g.Values = append(g.Values, row.Get(idx))
+		g.nulls = append(g.nulls, d.isNull(row, idx))
+	}
+	return g
+}
+
+func (d *Dataset) groupKey(row *Row, idxs []int) string {
+	var sb strings.Builder
+	for _, idx := range idxs {
+		if d.isNull(row, idx) {
+			sb.WriteString("null")
+		} else {
+			sb.WriteString(strconv.Quote(row.Get(idx)))
+		}
+		sb.WriteByte(',')
+	}
+	return sb.String()
+}
+
+// AggCount counts the values.
+func AggCount(values []string) (string, error) {
+	return strconv.Itoa(len(values)), nil
+}
+
+// AggCountDistinct counts the distinct values.
+func AggCountDistinct(values []string) (string, error) {
+	set := newStringSet()
+	for _, val := range values {
+		set.Add(val)
+	}
+	return strconv.Itoa(set.Len()), nil
+}
+
+// AggSum sums numeric values, decimal values are added exactly.
+func AggSum(values []string) (string, error) {
+	if sum, ok := sumDecimals(values); ok {
+		return formatRat(sum), nil
+	}
+	sum, err := sumValues(values)
+	if err != nil {
+		return "", err
+	}
+	return formatFloat(sum), nil
+}
+
+// AggAvg computes arithmetic mean of numeric values, empty value is
+// returned when there are no values. Mean of decimal values is exact
+// unless it has infinite decimal expansion.
+func AggAvg(values []string) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+	if sum, ok := sumDecimals(values); ok {
+		return formatRat(sum.Quo(sum, big.NewRat(int64(len(values)), 1))), nil
+	}
+	sum, err := sumValues(values)
+	if err != nil {
+		return "", err
+	}
+	return formatFloat(sum / float64(len(values))), nil
+}
+
+// AggMin returns the smallest value, values are compared as numbers when
+// numeric and as strings otherwise.
+func AggMin(values []string) (string, error) {
+	return pickValue(values, -1), nil
+}
+
+// AggMax returns the largest value, values are compared as numbers when
+// numeric and as strings otherwise.
+func AggMax(values []string) (string, error) {
+	return pickValue(values, 1), nil
+}
+
+// AggFirst returns the first value.
+func AggFirst(values []string) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+	return values[0], nil
+}
+
+// AggLast returns the last value.
+func AggLast(values []string) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+	return values[len(values)-1], nil
+}
+
+// AggJoin returns aggregator joining the values using sep.
+func AggJoin(sep string) Aggregator {
+	return func(values []string) (string, error) {
+		return strings.Join(values, sep), nil
+	}
+}
+
+// sumDecimals sums values exactly, false is returned when some value is
+// not a plain decimal.
+func sumDecimals(values []string) (*big.Rat, bool) {
+	sum := new(big.Rat)
+	for _, val := range values {
+		dec, err := parseDecimal(val)
+		if err != nil {
+			return nil, false
+		}
+		r, ok := new(big.Rat).SetString(dec)
+		if !ok {
+			return nil, false
+		}
+		sum.Add(sum, r)
+	}
+	return sum, true
+}
+
+func sumValues(values []string) (float64, error) {
+	var sum float64
+	for _, val := range values {
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return 0, err
+		}
+		sum += f
+	}
+	return sum, nil
+}
+
+func pickValue(values []string, sign int) string {
+	var res string
+	for idx, val := range values {
+		if idx == 0 || compareValues(TypeAny, val, res)*sign > 0 {
+			res = val
+		}
+	}
+	return res
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// formatRat formats r as decimal with as few digits as needed, values
+// with infinite decimal expansion are formatted as floats.
+func formatRat(r *big.Rat) string {
+	// decimal expansion is finite when denominator has no other prime
+	// factors than 2 and 5, their larger power is the count of digits
+	denom := new(big.Int).Set(r.Denom())
+	scale := 0
+	for _, factor := range []int64{2, 5} {
+		prime := big.NewInt(factor)
+		quo, rem := new(big.Int), new(big.Int)
+		power := 0
+		for {
+			if quo.QuoRem(denom, prime, rem); rem.Sign() != 0 {
+				break
+			}
+			denom.Set(quo)
+			power++
+		}
+		if power > scale {
+			scale = power
+		}
+	}
+
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		f, _ := r.Float64()
+		return formatFloat(f)
+	}
+	return r.FloatString(scale)
+}
@@ -0,0 +1,178 @@
+package tabular
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GroupTestSuite struct {
+	suite.Suite
+}
+
+func (s *GroupTestSuite) newDataset() *Dataset {
+	d := NewDataSet()
+	d.AddHeader("dept", "Department")
+	d.AddHeader("city", "City")
+	d.AddHeader("name", "Name")
+	d.AddHeader("salary", "Salary")
+
+	s.Require().NoError(d.Append(
+		NewRow("sales", "Prague", "Julia", "1200"),
+		NewRow("dev", "Brno", "John", "2000"),
+		NewRow("sales", "Prague", "Bill", "900.5"),
+		NewRow("dev", "Prague", "Anna", "2500"),
+		NewRow("sales", "Brno", "Tom", "900.5"),
+	))
+	r := NewRow("dev", "Brno", "Eve", "")
+	r.SetNull(3)
+	s.Require().NoError(d.Append(r))
+	return d
+}
+
+func (s *GroupTestSuite) TestGroupBy() {
+	d := s.newDataset()
+
+	g, err := d.GroupBy("dept")
+	s.NoError(err)
+	s.Equal(2, g.Len())
+
+	groups := g.Groups()
+	s.Equal([]string{"sales"}, groups[0].Values)
+	s.Equal([]string{"Julia", "Bill", "Tom"}, groups[0].Dataset.GetColValues("name"))
+	s.Equal([]string{"dev"}, groups[1].Values)
+	s.Equal(3, groups[1].Dataset.Len())
+	s.Equal(4, groups[1].Dataset.GetIdxWidth(2))
+
+	_, err = d.GroupBy("missing")
+	s.Equal(ErrColumnNotFound{"missing"}, err)
+}
+
+func (s *GroupTestSuite) TestAggregate() {
+	d := s.newDataset()
+
+	g, err := d.GroupBy("dept")
+	s.NoError(err)
+
+	res, err := g.Aggregate(
+		Aggregation{Key: "rows", Func: AggCount},
+		Aggregation{Key: "paid", Col: "salary", Func: AggCount},
+		Aggregation{Key: "cities", Col: "city", Func: AggCountDistinct},
+		Aggregation{Key: "total", Title: "Total", Col: "salary", Func: AggSum},
+		Aggregation{Key: "avg", Col: "salary", Func: AggAvg},
+		Aggregation{Key: "min", Col: "salary", Func: AggMin},
+		Aggregation{Key: "max", Col: "salary", Func: AggMax},
+		Aggregation{Key: "first", Col: "name", Func: AggFirst},
+		Aggregation{Key: "last", Col: "name", Func: AggLast},
+		Aggregation{Key: "names", Col: "name", Func: AggJoin(", ")},
+	)
+	s.NoError(err)
+
+	hdr, _ := res.GetHeader(0)
+	s.Equal("Department", hdr.Title)
+	hdr, _ = res.GetHeader(4)
+	s.Equal("Total", hdr.Title)
+	hdr, _ = res.GetHeader(5)
+	s.Equal("avg", hdr.Title)
+
+	r1, _ := res.Get(0)
+	r2, _ := res.Get(1)
+	s.Equal([]string{"sales", "3", "3", "2", "3001", "1000.3333333333334", "900.5", "1200", "Julia", "Tom", "Julia, Bill, Tom"}, r1.Items())
+	s.Equal([]string{"dev", "3", "2", "2", "4500", "2250", "2000", "2500", "John", "Eve", "John, Anna, Eve"}, r2.Items())
+}
+
+func (s *GroupTestSuite) TestAggregateMultipleKeys() {
+	d := s.newDataset()
+	r := NewRow("", "Brno", "Zoe", "10")
+	r.SetNull(0)
+	s.NoError(d.Append(r, NewRow("", "Brno", "Max", "20")))
+
+	g, err := d.GroupBy("dept", "city")
+	s.NoError(err)
+
+	res, err := g.Aggregate(Aggregation{Key: "count", Func: AggCount})
+	s.NoError(err)
+	s.Equal([]string{"sales", "dev", "dev", "sales", "", ""}, res.GetColValues("dept"))
+	s.Equal([]string{"Prague", "Brno", "Prague", "Brno", "Brno", "Brno"}, res.GetColValues("city"))
+	s.Equal([]string{"2", "2", "1", "1", "1", "1"}, res.GetColValues("count"))
+
+	last, _ := res.Get(4)
+	s.True(last.IsNull(0))
+	last, _ = res.Get(5)
+	s.False(last.IsNull(0))
+}
+
+func (s *GroupTestSuite) TestAggregateCustom() {
+	d := s.newDataset()
+
+	g, err := d.GroupBy("dept")
+	s.NoError(err)
+
+	longest := func(values []string) (string, error) {
+		var res string
+		for _, val := range values {
+			if len(val) > len(res) {
+				res = val
+			}
+		}
+		return res, nil
+	}
+	res, err := g.Aggregate(Aggregation{Key: "longest", Col: "name", Func: longest})
+	s.NoError(err)
+	s.Equal([]string{"Julia", "John"}, res.GetColValues("longest"))
+}
+
+func (s *GroupTestSuite) TestAggregateErrors() {
+	d := s.newDataset()
+
+	g, err := d.GroupBy("dept")
+	s.NoError(err)
+
+	_, err = g.Aggregate(Aggregation{Key: "total", Col: "name", Func: AggSum})
+	s.Error(err)
+	s.True(errors.Is(err, strconv.ErrSyntax))
+	s.Equal(`Aggregate total failed: strconv.ParseFloat: parsing "Julia": invalid syntax.`, err.Error())
+
+	_, err = g.Aggregate(Aggregation{Key: "dept", Func: AggCount})
+	s.Equal(ErrDuplicateColumn{"dept"}, err)
+
+	_, err = g.Aggregate(Aggregation{Key: "x", Col: "missing", Func: AggCount})
+	s.Equal(ErrColumnNotFound{"missing"}, err)
+}
+
+func (s *GroupTestSuite) TestAggregators() {
+	val, err := AggAvg(nil)
+	s.NoError(err)
+	s.Equal("", val)
+
+	val, err = AggSum([]string{"0.1", "0.2"})
+	s.NoError(err)
+	s.Equal("0.3", val)
+	val, _ = AggSum([]string{"1.50", "+1.50", "-0.25"})
+	s.Equal("2.75", val)
+	val, _ = AggSum([]string{"12345678901234567890.1", "0.2"})
+	s.Equal("12345678901234567890.3", val)
+	val, _ = AggSum([]string{"1e3", "0.5"})
+	s.Equal("1000.5", val)
+	val, _ = AggAvg([]string{"0.1", "0.2"})
+	s.Equal("0.15", val)
+	val, _ = AggAvg([]string{"1", "2"})
+	s.Equal("1.5", val)
+	val, _ = AggAvg([]string{"1", "1", "2"})
+	s.Equal("1.3333333333333333", val)
+
+	val, _ = AggMin([]string{"b", "a", "c"})
+	s.Equal("a", val)
+	val, _ = AggMax([]string{"9", "10", "1"})
+	s.Equal("10", val)
+	val, _ = AggFirst(nil)
+	s.Equal("", val)
+	val, _ = AggCountDistinct([]string{"a", "b", "a"})
+	s.Equal("2", val)
+}
+
+func TestGroupTestSuite(t *testing.T) {
+	suite.Run(t, new(GroupTestSuite))
+}
@@ -4,6 +4,12 @@ package tabular
 type Header struct {
 	Key   string
 	Title string
+
+	// Type is the type of column values, untyped columns use TypeAny.
+	Type ColumnType
+
+	// Nullable allows null values in typed columns, empty values are treated as null.
+	Nullable bool
 }
 
 // Headers represents dataset headers.
@@ -13,7 +19,17 @@ type Headers struct {
 
 // Add appends a new header.
 func (h *Headers) Add(key string, title string) {
-	h.items = append(h.items, &Header{key, title})
+	h.AddTyped(key, title, TypeAny, false)
+}
+
+// AddTyped appends a new header with column type.
+func (h *Headers) AddTyped(key string, title string, typ ColumnType, nullable bool) {
+	h.items = append(h.items, &Header{
+		Key:      key,
+		Title:    title,
+		Type:     typ,
+		Nullable: nullable,
+	})
 }
 
 // Get returns header on given index.
@@ -39,9 +55,64 @@ func (h *Headers) Empty() bool {
 	return h.Len() == 0
 }
 
+func (h *Headers) clone() *Headers {
+	items := make([]*Header, 0, len(h.items))
+	for _, hdr := range h.items {
+		cp := *hdr
+		items = append(items, &cp)
+	}
+	return &Headers{items: items}
+}
+
 func (h *Headers) isValidIndex(idx int) bool {
 	if h.Empty() {
 		return false
 	}
-	return idx >= 0 && idx <= h.Len()
+	return idx >= 0 && idx < h.Len()
+}
+
+// isNull returns true if item on given index of the row is treated as null
+// in the column. Empty values of nullable typed columns are null too.
+func (h *Header) isNull(r *Row, idx int) bool {
+	if r.IsNull(idx) {
+		return true
+	}
+	return h.Nullable && h.Type != TypeAny && h.Type != TypeString && r.Get(idx) == ""
+}
+
+// validate checks that item on given index of the row matches the column type.
+func (h *Header) validate(r *Row, idx int) error {
+	if r.IsNull(idx) {
+		if h.Type != TypeAny && !h.Nullable {
+			return ErrNullValue{h.Key}
+		}
+		return nil
+	}
+
+	val := r.Get(idx)
+	if h.Type == TypeAny || h.Type == TypeString || h.isNull(r, idx) {
+		return nil
+	}
+	if _, err := parseValue(h.Type, val); err != nil {
+		return ErrInvalidValue{
+			key:   h.Key,
+			value: val,
+			typ:   h.Type,
+		}
+	}
+	return nil
+}
+
+// value returns native value of item on given index of the row, nil is
+// returned for null values.
+func (h *Header) value(r *Row, idx int) interface{} {
+	if h.isNull(r, idx) {
+		return nil
+	}
+	val := r.Get(idx)
+	v, err := parseValue(h.Type, val)
+	if err != nil {
+		return val
+	}
+	return v
 }
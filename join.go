@@ -0,0 +1,260 @@
+package tabular
+
+import (
+	"errors"
+)
+
+var (
+	// ErrNoJoinKeys is returned when joining datasets without key columns.
+	ErrNoJoinKeys = errors.New("join needs at least one key column")
+)
+
+// JoinType represents the kind of join.
+type JoinType int
+
+const (
+	// InnerJoin keeps only rows with matching keys on both sides.
+	InnerJoin JoinType = iota
+
+	// LeftJoin keeps all rows of the left dataset.
+	LeftJoin
+
+	// RightJoin keeps all rows of the right dataset.
+	RightJoin
+
+	// FullJoin keeps all rows of both datasets.
+	FullJoin
+)
+
+// JoinOpts represents options passed to Join.
+type JoinOpts struct {
+	Type JoinType
+
+	// On are key columns present in both datasets.
+	On []string
+
+	// LeftSuffix and RightSuffix are appended to keys and titles of non-key
+	// columns present in both datasets, _left and _right are used when empty.
+	LeftSuffix  string
+	RightSuffix string
+}
+
+// Join returns new dataset joining rows of d and other with equal values of
+// key columns. Headers are the key columns followed by other columns of d
+// and other columns of the right dataset. Values missing on one side of
+// outer joins are null, null keys never match. Key columns of right and full
+// joins are nullable when the right key column is and untyped when key column
+// types differ. Tags of joined rows are merged.
+func (d *Dataset) Join(other *Dataset, opts *JoinOpts) (*Dataset, error) {
+	if len(opts.On) == 0 {
+		return nil, ErrNoJoinKeys
+	}
+	j := &joiner{
+		left:  d,
+		right: other,
+		opts:  opts,
+	}
+	return j.join()
+}
+
+type joiner struct {
+	left  *Dataset
+	right *Dataset
+	opts  *JoinOpts
+
+	leftKeys  []int
+	rightKeys []int
+	leftCols  []int
+	rightCols []int
+
+	res *Dataset
+}
+
+func (j *joiner) join() (*Dataset, error) {
+	var err error
+	if j.leftKeys, err = j.left.columnIndexesOf(j.opts.On); err != nil {
+		return nil, err
+	}
+	if j.rightKeys, err = j.right.columnIndexesOf(j.opts.On); err != nil {
+		return nil, err
+	}
+	j.leftCols = otherColumns(j.left, j.leftKeys)
+	j.rightCols = otherColumns(j.right, j.rightKeys)
+
+	if err := j.headers(); err != nil {
+		return nil, err
+	}
+
+	// build hash table of the right dataset
+	index := make(map[string][]int)
+	for idx, row := range j.right.rows {
+		if key, ok := joinKey(j.right, row, j.rightKeys); ok {
+			index[key] = append(index[key], idx)
+		}
+	}
+
+	keepLeft := j.opts.Type == LeftJoin || j.opts.Type == FullJoin
+	keepRight := j.opts.Type == RightJoin || j.opts.Type == FullJoin
+	matched := make([]bool, len(j.right.rows))
+
+	for _, lrow := range j.left.rows {
+		var matches []int
+		if key, ok := joinKey(j.left, lrow, j.leftKeys); ok {
+			matches = index[key]
+		}
+		for _, ridx := range matches {
+			matched[ridx] = true
+			if err := j.append(lrow, j.right.rows[ridx]); err != nil {
+				return nil, err
+			}
+		}
+		if len(matches) == 0 && keepLeft {
+			if err := j.append(lrow, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if keepRight {
+		for ridx, rrow := range j.right.rows {
+			if matched[ridx] {
+				continue
+			}
+			if err := j.append(nil, rrow); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return j.res, nil
+}
+
+func (j *joiner) headers() error {
+	leftOptional := j.opts.Type == RightJoin || j.opts.Type == FullJoin
+	rightOptional := j.opts.Type == LeftJoin || j.opts.Type == FullJoin
+
+	leftSuffix, rightSuffix := j.opts.LeftSuffix, j.opts.RightSuffix
+	if leftSuffix == "" {
+		leftSuffix = "_left"
+	}
+	if rightSuffix == "" {
+		rightSuffix = "_right"
+	}
+
+	leftSet := columnKeys(j.left, j.leftCols)
+	rightSet := columnKeys(j.right, j.rightCols)
+
+	var hdrs []*Header
+	for i, idx := range j.leftKeys {
+		hdr, _ := j.left.GetHeader(idx)
+		cp := *hdr
+		if leftOptional {
+			// keys of unmatched right rows come from the right dataset
+			rhdr, _ := j.right.GetHeader(j.rightKeys[i])
+			cp.Nullable = cp.Nullable || rhdr.Nullable
+			if cp.Type != rhdr.Type {
+				cp.Type = TypeAny
+			}
+		}
+		hdrs = append(hdrs, &cp)
+	}
+	for _, idx := range j.leftCols {
+		hdr, _ := j.left.GetHeader(idx)
+		cp := *hdr
+		cp.Nullable = cp.Nullable || leftOptional
+		if rightSet.Contains(cp.Key) {
+			cp.Key += leftSuffix
+			cp.Title += leftSuffix
+		}
+		hdrs = append(hdrs, &cp)
+	}
+	for _, idx := range j.rightCols {
+		hdr, _ := j.right.GetHeader(idx)
+		cp := *hdr
+		cp.Nullable = cp.Nullable || rightOptional
+		if leftSet.Contains(cp.Key) {
+			cp.Key += rightSuffix
+			cp.Title += rightSuffix
+		}
+		hdrs = append(hdrs, &cp)
+	}
+
+	j.res = NewDataSet()
+	for _, hdr := range hdrs {
+		if j.res.HasCol(hdr.Key) {
+			return ErrDuplicateColumn{hdr.Key}
+		}
+		j.res.AddTypedHeader(hdr.Key, hdr.Title, hdr.Type, hdr.Nullable)
+	}
+	return nil
+}
+
+// append appends joined row, nil row represents missing side of outer join.
+func (j *joiner) append(lrow *Row, rrow *Row) error {
+	var row *Row
+	if lrow != nil {
+		row = lrow.project(j.leftKeys)
+	} else {
+		row = rrow.project(j.rightKeys)
+	}
+
+	addColumns(row, lrow, j.leftCols)
+	addColumns(row, rrow, j.rightCols)
+
+	for _, r := range []*Row{lrow, rrow} {
+		if r == nil {
+			continue
+		}
+		for _, tag := range r.Tags() {
+			row.AddTag(tag)
+		}
+	}
+	return j.res.Append(row)
+}
+
+// addColumns adds items on given indexes of src to row, nulls are added when src is nil.
+func addColumns(row *Row, src *Row, idxs []int) {
+	for _, idx := range idxs {
+		if src == nil || src.IsNull(idx) {
+			row.AddNull()
+		} else {
+			row.Add(src.Get(idx))
+		}
+	}
+}
+
+// joinKey returns hash key of the row, false is returned for null keys.
+func joinKey(d *Dataset, row *Row, idxs []int) (string, bool) {
+	for _, idx := range idxs {
+		if d.isNull(row, idx) {
+			return "", false
+		}
+	}
+	return d.groupKey(row, idxs), true
+}
+
+// columnKeys returns set of keys of columns on given indexes.
+func columnKeys(d *Dataset, idxs []int) stringSet {
+	keys := newStringSet()
+	for _, idx := range idxs {
+		hdr, _ := d.GetHeader(idx)
+		keys.Add(hdr.Key)
+	}
+	return keys
+}
+
+// otherColumns returns indexes of columns not present in keys.
+func otherColumns(d *Dataset, keys []int) []int {
+	skip := make(map[int]bool, len(keys))
+	for _, idx := range keys {
+		skip[idx] = true
+	}
+
+	var idxs []int
+	for idx := range d.Headers() {
+		if !skip[idx] {
+			idxs = append(idxs, idx)
+		}
+	}
+	return idxs
+}
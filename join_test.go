@@ -0,0 +1,236 @@
+package tabular
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type JoinTestSuite struct {
+	suite.Suite
+}
+
+func (s *JoinTestSuite) newUsers() *Dataset {
+	d := NewDataSet()
+	d.AddHeader("id", "ID")
+	d.AddHeader("name", "Name")
+	d.AddHeader("plan", "Plan")
+
+	r1 := NewRow("1", "Julia", "pro")
+	r1.AddTag("vip")
+	r4 := NewRow("", "Ghost", "free")
+	r4.SetNull(0)
+	s.Require().NoError(d.Append(
+		r1,
+		NewRow("2", "John", "free"),
+		NewRow("3", "Bill", "pro"),
+		r4,
+	))
+	return d
+}
+
+func (s *JoinTestSuite) newBilling() *Dataset {
+	d := NewDataSet()
+	d.AddHeader("id", "User ID")
+	d.AddHeader("plan", "Billed plan")
+	d.AddHeader("amount", "Amount")
+
+	r1 := NewRow("1", "pro", "10")
+	r1.AddTag("paid")
+	r4 := NewRow("", "free", "0")
+	r4.SetNull(0)
+	s.Require().NoError(d.Append(
+		r1,
+		NewRow("1", "pro", "12"),
+		NewRow("3", "pro", "8"),
+		NewRow("4", "free", "0"),
+		r4,
+	))
+	return d
+}
+
+func (s *JoinTestSuite) items(d *Dataset) [][]string {
+	var items [][]string
+	for _, row := range d.Rows() {
+		items = append(items, row.Items())
+	}
+	return items
+}
+
+func (s *JoinTestSuite) TestInnerJoin() {
+	res, err := s.newUsers().Join(s.newBilling(), &JoinOpts{
+		On: []string{"id"},
+	})
+	s.NoError(err)
+
+	var keys []string
+	for _, hdr := range res.Headers() {
+		keys = append(keys, hdr.Key)
+	}
+	s.Equal([]string{"id", "name", "plan_left", "plan_right", "amount"}, keys)
+	hdr, _ := res.GetHeader(0)
+	s.Equal("ID", hdr.Title)
+	hdr, _ = res.GetHeader(2)
+	s.Equal("Plan_left", hdr.Title)
+	hdr, _ = res.GetHeader(3)
+	s.Equal("Billed plan_right", hdr.Title)
+
+	s.Equal([][]string{
+		{"1", "Julia", "pro", "pro", "10"},
+		{"1", "Julia", "pro", "pro", "12"},
+		{"3", "Bill", "pro", "pro", "8"},
+	}, s.items(res))
+
+	row, _ := res.Get(0)
+	s.True(row.HasAllTags("vip", "paid"))
+	row, _ = res.Get(1)
+	s.True(row.HasTag("vip"))
+	s.False(row.HasTag("paid"))
+}
+
+func (s *JoinTestSuite) TestLeftJoin() {
+	res, err := s.newUsers().Join(s.newBilling(), &JoinOpts{
+		Type:        LeftJoin,
+		On:          []string{"id"},
+		LeftSuffix:  "_user",
+		RightSuffix: "_billing",
+	})
+	s.NoError(err)
+	s.Equal([]string{"1", "1", "2", "3", ""}, res.GetColValues("id"))
+	s.Equal([]string{"10", "12", "", "8", ""}, res.GetColValues("amount"))
+	s.True(res.HasCol("plan_user"))
+	s.True(res.HasCol("plan_billing"))
+
+	sel, err := res.SelectColumns("id", "plan_user", "plan_billing")
+	s.NoError(err)
+	out, err := newTestWrite(sel, NewCSVWriter(&CSVOpts{Comma: ','}))
+	s.NoError(err)
+	s.Contains(out, "ID,Plan_user,Billed plan_billing\n")
+
+	row, _ := res.Get(2)
+	s.True(row.IsNull(3))
+	s.True(row.IsNull(4))
+	row, _ = res.Get(4)
+	s.True(row.IsNull(0))
+}
+
+func (s *JoinTestSuite) TestRightJoin() {
+	res, err := s.newUsers().Join(s.newBilling(), &JoinOpts{
+		Type: RightJoin,
+		On:   []string{"id"},
+	})
+	s.NoError(err)
+	s.Equal([]string{"1", "1", "3", "4", ""}, res.GetColValues("id"))
+	s.Equal([]string{"Julia", "Julia", "Bill", "", ""}, res.GetColValues("name"))
+
+	row, _ := res.Get(3)
+	s.True(row.IsNull(1))
+	s.False(row.IsNull(0))
+	row, _ = res.Get(4)
+	s.True(row.IsNull(0))
+}
+
+func (s *JoinTestSuite) TestFullJoin() {
+	res, err := s.newUsers().Join(s.newBilling(), &JoinOpts{
+		Type: FullJoin,
+		On:   []string{"id"},
+	})
+	s.NoError(err)
+	s.Equal([]string{"1", "1", "2", "3", "", "4", ""}, res.GetColValues("id"))
+	s.Equal([]string{"Julia", "Julia", "John", "Bill", "Ghost", "", ""}, res.GetColValues("name"))
+	s.Equal([]string{"10", "12", "", "8", "", "0", "0"}, res.GetColValues("amount"))
+}
+
+func (s *JoinTestSuite) TestJoinMultipleKeys() {
+	res, err := s.newUsers().Join(s.newBilling(), &JoinOpts{
+		On: []string{"id", "plan"},
+	})
+	s.NoError(err)
+	s.Equal(4, res.HeaderCount())
+	s.Equal([]string{"10", "12", "8"}, res.GetColValues("amount"))
+}
+
+func (s *JoinTestSuite) TestJoinTyped() {
+	left := NewDataSet()
+	left.AddTypedHeader("id", "ID", TypeInt, false)
+	left.AddTypedHeader("score", "Score", TypeInt, false)
+	s.NoError(left.Append(NewRow("1", "5"), NewRow("2", "7")))
+
+	right := NewDataSet()
+	right.AddTypedHeader("id", "ID", TypeInt, false)
+	right.AddTypedHeader("rank", "Rank", TypeInt, false)
+	s.NoError(right.Append(NewRow("1", "3")))
+
+	res, err := left.Join(right, &JoinOpts{
+		Type: LeftJoin,
+		On:   []string{"id"},
+	})
+	s.NoError(err)
+	hdr, _ := res.GetHeader(2)
+	s.Equal(TypeInt, hdr.Type)
+	s.True(hdr.Nullable)
+	hdr, _ = res.GetHeader(1)
+	s.False(hdr.Nullable)
+}
+
+func (s *JoinTestSuite) TestJoinErrors() {
+	users := s.newUsers()
+
+	_, err := users.Join(s.newBilling(), &JoinOpts{})
+	s.Equal(ErrNoJoinKeys, err)
+
+	_, err = users.Join(s.newBilling(), &JoinOpts{On: []string{"name"}})
+	s.Equal(ErrColumnNotFound{"name"}, err)
+
+	billing := s.newBilling()
+	s.NoError(billing.RenameColumn("amount", "plan_right"))
+	_, err = users.Join(billing, &JoinOpts{On: []string{"id"}})
+	s.Equal(ErrDuplicateColumn{"plan_right"}, err)
+}
+
+func (s *JoinTestSuite) TestFullJoinTypedKeys() {
+	left := NewDataSet()
+	left.AddTypedHeader("id", "ID", TypeInt, false)
+	left.AddHeader("name", "Name")
+	s.Require().NoError(left.Append(NewRow("1", "Julia"), NewRow("2", "John")))
+
+	right := NewDataSet()
+	right.AddTypedHeader("id", "ID", TypeInt, true)
+	right.AddHeader("amount", "Amount")
+	r := NewRow("", "5")
+	r.SetNull(0)
+	s.Require().NoError(right.Append(NewRow("1", "10"), r))
+
+	res, err := left.Join(right, &JoinOpts{Type: FullJoin, On: []string{"id"}})
+	s.NoError(err)
+	hdr, _ := res.GetHeader(0)
+	s.Equal(TypeInt, hdr.Type)
+	s.True(hdr.Nullable)
+	s.Equal([][]string{
+		{"1", "Julia", "10"},
+		{"2", "John", ""},
+		{"", "", "5"},
+	}, s.items(res))
+	row, _ := res.Get(2)
+	s.True(row.IsNull(0))
+
+	other := NewDataSet()
+	other.AddHeader("id", "ID")
+	s.Require().NoError(other.Append(NewRow("x")))
+
+	res, err = left.Join(other, &JoinOpts{Type: RightJoin, On: []string{"id"}})
+	s.NoError(err)
+	hdr, _ = res.GetHeader(0)
+	s.Equal(TypeAny, hdr.Type)
+	s.Equal([]string{"x"}, res.GetColValues("id"))
+
+	res, err = left.Join(other, &JoinOpts{Type: LeftJoin, On: []string{"id"}})
+	s.NoError(err)
+	hdr, _ = res.GetHeader(0)
+	s.Equal(TypeInt, hdr.Type)
+	s.False(hdr.Nullable)
+}
+
+func TestJoinTestSuite(t *testing.T) {
+	suite.Run(t, new(JoinTestSuite))
+}
@@ -0,0 +1,102 @@
+package tabular
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// ErrInvalidRecord is error returned when reader fails to load a record.
+type ErrInvalidRecord struct {
+	line int
+	err  error
+}
+
+func (e ErrInvalidRecord) Error() string {
+	return fmt.Sprintf("Line %d: %v", e.line, e.err)
+}
+
+// Line returns the line number on which the invalid record starts.
+func (e ErrInvalidRecord) Line() int {
+	return e.line
+}
+
+// Unwrap returns the underlying error.
+func (e ErrInvalidRecord) Unwrap() error {
+	return e.err
+}
+
+// CSVReaderOpts represents options passed to the CSV reader.
+type CSVReaderOpts struct {
+	Comma            rune
+	Comment          rune
+	LazyQuotes       bool
+	TrimLeadingSpace bool
+	HasHeaders       bool
+}
+
+// NewCSVReader creates a new CSV dataset reader.
+func NewCSVReader(opts *CSVReaderOpts) *CSVReader {
+	r := &CSVReader{opts}
+	return r
+}
+
+// CSVReader represents a CSV dataset reader.
+type CSVReader struct {
+	opts *CSVReaderOpts
+}
+
+// Name returns name of the reader.
+func (rc *CSVReader) Name() string {
+	return "csv"
+}
+
+// Read reads dataset from reader.
+func (rc *CSVReader) Read(r io.Reader) (*Dataset, error) {
+	cr := csv.NewReader(r)
+	if rc.opts.Comma != 0 {
+		cr.Comma = rc.opts.Comma
+	}
+	cr.Comment = rc.opts.Comment
+	cr.LazyQuotes = rc.opts.LazyQuotes
+	cr.TrimLeadingSpace = rc.opts.TrimLeadingSpace
+	// reader enforces width of the first record and reports line numbers
+	cr.FieldsPerRecord = 0
+
+	d := NewDataSet()
+	first := true
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+
+		// records of invalid width are returned along with the error,
+		// they are passed to the dataset to report its validation error
+		line := 0
+		if perr, ok := err.(*csv.ParseError); ok && perr.Err == csv.ErrFieldCount {
+			line = perr.StartLine
+		} else if err != nil {
+			return nil, err
+		}
+
+		if first && rc.opts.HasHeaders {
+			for _, title := range record {
+				d.AddHeader(title, title)
+			}
+			first = false
+			continue
+		}
+		first = false
+
+		if err := d.Append(NewRowFromSlice(record)); err != nil {
+			return nil, ErrInvalidRecord{
+				line: line,
+				err:  err,
+			}
+		}
+	}
+
+	return d, nil
+}
@@ -0,0 +1,103 @@
+package tabular
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CSVReaderTestSuite struct {
+	suite.Suite
+}
+
+func (s *CSVReaderTestSuite) TestRead() {
+	opts := &CSVReaderOpts{
+		Comma:      ';',
+		HasHeaders: true,
+	}
+	r := NewCSVReader(opts)
+	in := `First name;Last name;Age
+Julia;Roberts;40
+John;Malkovich;42
+`
+	d, err := r.Read(strings.NewReader(in))
+	s.NoError(err)
+	s.Equal(3, d.HeaderCount())
+	s.Equal(2, d.Len())
+
+	hdr, _ := d.GetHeader(1)
+	s.Equal("Last name", hdr.Key)
+	s.Equal("Last name", hdr.Title)
+	s.Equal([]string{"Julia", "John"}, d.GetColValues("First name"))
+}
+
+func (s *CSVReaderTestSuite) TestReadWithoutHeaders() {
+	opts := &CSVReaderOpts{
+		Comma: ',',
+	}
+	r := NewCSVReader(opts)
+	in := "Julia,Roberts,40\nJohn,Malkovich,42\n"
+
+	d, err := r.Read(strings.NewReader(in))
+	s.NoError(err)
+	s.False(d.HasHeaders())
+	s.Equal(2, d.Len())
+
+	row, _ := d.Get(1)
+	s.Equal([]string{"John", "Malkovich", "42"}, row.Items())
+}
+
+func (s *CSVReaderTestSuite) TestReadOpts() {
+	opts := &CSVReaderOpts{
+		Comma:            ',',
+		Comment:          '#',
+		LazyQuotes:       true,
+		TrimLeadingSpace: true,
+		HasHeaders:       true,
+	}
+	r := NewCSVReader(opts)
+	in := `name, nick
+# skipped
+Julia, Jules "R"
+`
+	d, err := r.Read(strings.NewReader(in))
+	s.NoError(err)
+	s.Equal(1, d.Len())
+
+	row, _ := d.Get(0)
+	s.Equal([]string{"Julia", `Jules "R"`}, row.Items())
+}
+
+func (s *CSVReaderTestSuite) TestReadInvalidWidth() {
+	opts := &CSVReaderOpts{
+		Comma:      ',',
+		HasHeaders: true,
+	}
+	r := NewCSVReader(opts)
+	in := "name,surname\nJulia,Roberts\n\nJohn\n"
+
+	_, err := r.Read(strings.NewReader(in))
+	s.Error(err)
+
+	var rerr ErrInvalidRecord
+	s.True(errors.As(err, &rerr))
+	s.Equal(4, rerr.Line())
+
+	var werr ErrInvalidRowWidth
+	s.True(errors.As(err, &werr))
+	s.Equal("Line 4: Invalid row width = 1, expected = 2.", err.Error())
+
+	in = "name,surname\n\"Ju\nlia\",Roberts\n# note\n\"John\nMalkovich\"\n"
+	r = NewCSVReader(&CSVReaderOpts{
+		Comma:   ',',
+		Comment: '#',
+	})
+	_, err = r.Read(strings.NewReader(in))
+	s.Equal("Line 5: Invalid row width = 1, expected = 2.", err.Error())
+}
+
+func TestCSVReaderTestSuite(t *testing.T) {
+	suite.Run(t, new(CSVReaderTestSuite))
+}
@@ -0,0 +1,223 @@
+package tabular
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	// ErrInvalidJSON is returned when JSON input is not an array of objects.
+	ErrInvalidJSON = errors.New("json must be an array of objects")
+)
+
+// ErrMissingKey is error returned when JSON object is missing a key.
+type ErrMissingKey struct {
+	row int
+	key string
+}
+
+func (e ErrMissingKey) Error() string {
+	return fmt.Sprintf("Object %d is missing key %s.", e.row, e.key)
+}
+
+// MissingKeyPolicy controls how the JSON reader handles missing object keys.
+type MissingKeyPolicy int
+
+const (
+	// MissingKeyError makes the reader fail on missing keys.
+	MissingKeyError MissingKeyPolicy = iota
+
+	// MissingKeyEmpty fills missing keys with empty string.
+	MissingKeyEmpty
+
+	// MissingKeyNull fills missing keys with null values.
+	MissingKeyNull
+)
+
+// JSONReaderOpts represents options passed to the JSON reader.
+type JSONReaderOpts struct {
+	MissingKeys MissingKeyPolicy
+}
+
+// NewJSONReader creates a new JSON dataset reader.
+func NewJSONReader(opts *JSONReaderOpts) *JSONReader {
+	r := &JSONReader{opts}
+	return r
+}
+
+// JSONReader represents a JSON dataset reader.
+type JSONReader struct {
+	opts *JSONReaderOpts
+}
+
+// Name returns name of the reader.
+func (rj *JSONReader) Name() string {
+	return "json"
+}
+
+// Read reads dataset from reader.
+func (rj *JSONReader) Read(r io.Reader) (*Dataset, error) {
+	tr := newJSONTableReader(r, rj.opts)
+	return tr.read()
+}
+
+func newJSONTableReader(r io.Reader, opts *JSONReaderOpts) *jsonTableReader {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return &jsonTableReader{
+		dec:  dec,
+		opts: opts,
+	}
+}
+
+type jsonTableReader struct {
+	dec  *json.Decoder
+	opts *JSONReaderOpts
+
+	keys    []string
+	seen    stringSet
+	objects []map[string]*string
+}
+
+func (j *jsonTableReader) read() (*Dataset, error) {
+	j.seen = newStringSet()
+
+	if err := j.expectDelim('['); err != nil {
+		return nil, err
+	}
+
+	for j.dec.More() {
+		obj, err := j.readObject()
+		if err != nil {
+			return nil, err
+		}
+		j.objects = append(j.objects, obj)
+	}
+
+	if err := j.expectDelim(']'); err != nil {
+		return nil, err
+	}
+
+	return j.dataset()
+}
+
+func (j *jsonTableReader) dataset() (*Dataset, error) {
+	d := NewDataSet()
+	for _, key := range j.keys {
+		d.AddHeader(key, key)
+	}
+
+	for idx, obj := range j.objects {
+		row := NewRow()
+		for _, key := range j.keys {
+			val, ok := obj[key]
+			if !ok {
+				switch j.opts.MissingKeys {
+				case MissingKeyEmpty:
+					val = new(string)
+				case MissingKeyNull:
+					val = nil
+				default:
+					return nil, ErrMissingKey{
+						row: idx,
+						key: key,
+					}
+				}
+			}
+			if val == nil {
+				row.AddNull()
+			} else {
+				row.Add(*val)
+			}
+		}
+		if err := d.Append(row); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+func (j *jsonTableReader) readObject() (map[string]*string, error) {
+	if err := j.expectDelim('{'); err != nil {
+		return nil, err
+	}
+
+	obj := make(map[string]*string)
+	for j.dec.More() {
+		tok, err := j.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, ErrInvalidJSON
+		}
+
+		var raw json.RawMessage
+		if err := j.dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+		val, err := j.stringify(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		obj[key] = val
+		if j.seen.Add(key) {
+			j.keys = append(j.keys, key)
+		}
+	}
+
+	if err := j.expectDelim('}'); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// stringify returns text representation of raw JSON value, nil is returned for null.
+func (j *jsonTableReader) stringify(raw json.RawMessage) (*string, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var val interface{}
+	if err := dec.Decode(&val); err != nil {
+		return nil, err
+	}
+
+	switch v := val.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return &v, nil
+	case json.Number:
+		s := v.String()
+		return &s, nil
+	case bool:
+		s := strconv.FormatBool(v)
+		return &s, nil
+	default:
+		// nested arrays and objects are kept as compact JSON
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, raw); err != nil {
+			return nil, err
+		}
+		s := buf.String()
+		return &s, nil
+	}
+}
+
+func (j *jsonTableReader) expectDelim(delim json.Delim) error {
+	tok, err := j.dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return ErrInvalidJSON
+	}
+	return nil
+}
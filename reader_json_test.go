@@ -0,0 +1,107 @@
+package tabular
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type JSONReaderTestSuite struct {
+	suite.Suite
+}
+
+func (s *JSONReaderTestSuite) TestReadRoundTrip() {
+	d, err := newTestDataset()
+	s.NoError(err)
+	out, err := newTestWrite(d, NewJSONWriter(&JSONOpts{Indent: 2}))
+	s.NoError(err)
+
+	r := NewJSONReader(&JSONReaderOpts{})
+	loaded, err := r.Read(strings.NewReader(out))
+	s.NoError(err)
+	s.Equal(d.HeaderCount(), loaded.HeaderCount())
+	s.Equal(d.Len(), loaded.Len())
+
+	for idx, hdr := range loaded.Headers() {
+		s.Equal(testHeaders[idx].Key, hdr.Key)
+	}
+	for idx, row := range loaded.Rows() {
+		s.Equal(testRows[idx], row.Items())
+	}
+}
+
+func (s *JSONReaderTestSuite) TestReadKeyOrder() {
+	r := NewJSONReader(&JSONReaderOpts{
+		MissingKeys: MissingKeyEmpty,
+	})
+	in := `[{"b": "1", "a": "2"}, {"c": "3", "a": "4"}]`
+
+	d, err := r.Read(strings.NewReader(in))
+	s.NoError(err)
+
+	var keys []string
+	for _, hdr := range d.Headers() {
+		keys = append(keys, hdr.Key)
+	}
+	s.Equal([]string{"b", "a", "c"}, keys)
+
+	r1, _ := d.Get(0)
+	r2, _ := d.Get(1)
+	s.Equal([]string{"1", "2", ""}, r1.Items())
+	s.Equal([]string{"", "4", "3"}, r2.Items())
+}
+
+func (s *JSONReaderTestSuite) TestReadMissingKeyError() {
+	r := NewJSONReader(&JSONReaderOpts{})
+	in := `[{"a": "1", "b": "2"}, {"a": "3"}]`
+
+	_, err := r.Read(strings.NewReader(in))
+	s.Error(err)
+	s.Equal(ErrMissingKey{row: 1, key: "b"}, err)
+}
+
+func (s *JSONReaderTestSuite) TestReadMissingKeyNull() {
+	r := NewJSONReader(&JSONReaderOpts{
+		MissingKeys: MissingKeyNull,
+	})
+	in := `[{"a": "1", "b": null}, {"a": "3"}, {"a": null, "b": ""}]`
+
+	d, err := r.Read(strings.NewReader(in))
+	s.NoError(err)
+	s.Equal([]string{"", "", ""}, d.GetColValues("b"))
+
+	r1, _ := d.Get(0)
+	r2, _ := d.Get(1)
+	r3, _ := d.Get(2)
+	s.False(r1.IsNull(0))
+	s.True(r1.IsNull(1))
+	s.True(r2.IsNull(1))
+	s.True(r3.IsNull(0))
+	s.False(r3.IsNull(1))
+}
+
+func (s *JSONReaderTestSuite) TestReadScalars() {
+	r := NewJSONReader(&JSONReaderOpts{})
+	in := `[{"int": 42, "float": 1.50, "bool": true, "list": [1, 2], "obj": {"x": "y"}}]`
+
+	d, err := r.Read(strings.NewReader(in))
+	s.NoError(err)
+
+	row, _ := d.Get(0)
+	s.Equal([]string{"42", "1.50", "true", "[1,2]", `{"x":"y"}`}, row.Items())
+}
+
+func (s *JSONReaderTestSuite) TestReadInvalid() {
+	r := NewJSONReader(&JSONReaderOpts{})
+
+	_, err := r.Read(strings.NewReader(`{"a": "1"}`))
+	s.Equal(ErrInvalidJSON, err)
+
+	_, err = r.Read(strings.NewReader(`["a"]`))
+	s.Equal(ErrInvalidJSON, err)
+}
+
+func TestJSONReaderTestSuite(t *testing.T) {
+	suite.Run(t, new(JSONReaderTestSuite))
+}
@@ -0,0 +1,505 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidCellRef is returned when spreadsheet contains invalid cell reference.
+	ErrInvalidCellRef = errors.New("invalid cell reference")
+)
+
+// ErrSheetNotFound is error returned when requested sheet does not exist.
+type ErrSheetNotFound struct {
+	sheet string
+}
+
+func (e ErrSheetNotFound) Error() string {
+	return fmt.Sprintf("Sheet %s not found.", e.sheet)
+}
+
+// XLSXReaderOpts represents options passed to the XLSX reader.
+type XLSXReaderOpts struct {
+	// Sheet selects sheet by name, SheetIndex is used when empty.
+	Sheet      string
+	SheetIndex int
+	HasHeaders bool
+
+	// DateLayout is used for date cells, defaults to 2006-01-02.
+	DateLayout string
+
+	// DateTimeLayout is used for date cells with time part, defaults to 2006-01-02 15:04:05.
+	DateTimeLayout string
+
+	// SkipBlankRows drops rows without any cells, they are loaded as empty rows by default.
+	SkipBlankRows bool
+}
+
+// NewXLSXReader creates a new XLSX dataset reader.
+func NewXLSXReader(opts *XLSXReaderOpts) *XLSXReader {
+	r := &XLSXReader{opts}
+	return r
+}
+
+// XLSXReader represents a Office Open XML spreadsheet dataset reader.
+type XLSXReader struct {
+	opts *XLSXReaderOpts
+}
+
+// Name returns name of the reader.
+func (rx *XLSXReader) Name() string {
+	return "xlsx"
+}
+
+// Read reads dataset from reader.
+func (rx *XLSXReader) Read(r io.Reader) (*Dataset, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	tr := newXLSXTableReader(zr, rx.opts)
+	return tr.read()
+}
+
+const (
+	defaultDateLayout     = "2006-01-02"
+	defaultDateTimeLayout = "2006-01-02 15:04:05"
+
+	xlsxWorkbookPath = "xl/workbook.xml"
+)
+
+type xlsxReadRelationships struct {
+	Relationships []xlsxRelationship `xml:"Relationship"`
+}
+
+type xlsxReadWorkbook struct {
+	Properties struct {
+		Date1904 bool `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []struct {
+		Name  string `xml:"name,attr"`
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxReadStyleSheet struct {
+	NumFmts []struct {
+		ID   int    `xml:"numFmtId,attr"`
+		Code string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtID int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type xlsxReadSharedStrings struct {
+	Items []xlsxReadStringItem `xml:"si"`
+}
+
+type xlsxReadStringItem struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (si xlsxReadStringItem) String() string {
+	if len(si.Runs) == 0 {
+		return si.Text
+	}
+	var b strings.Builder
+	b.WriteString(si.Text)
+	for _, r := range si.Runs {
+		b.WriteString(r.Text)
+	}
+	return b.String()
+}
+
+type xlsxReadWorksheet struct {
+	Rows []struct {
+		R     int            `xml:"r,attr"`
+		Cells []xlsxReadCell `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+type xlsxReadCell struct {
+	R      string             `xml:"r,attr"`
+	S      int                `xml:"s,attr"`
+	T      string             `xml:"t,attr"`
+	Value  string             `xml:"v"`
+	Inline xlsxReadStringItem `xml:"is"`
+}
+
+func newXLSXTableReader(zr *zip.Reader, opts *XLSXReaderOpts) *xlsxTableReader {
+	return &xlsxTableReader{
+		zr:   zr,
+		opts: opts,
+	}
+}
+
+type xlsxTableReader struct {
+	zr   *zip.Reader
+	opts *XLSXReaderOpts
+
+	date1904    bool
+	shared      []string
+	dateStyles  map[int]bool
+	sheetTarget string
+}
+
+func (x *xlsxTableReader) read() (*Dataset, error) {
+	if err := x.readWorkbook(); err != nil {
+		return nil, err
+	}
+
+	var ws xlsxReadWorksheet
+	if err := x.readXML(x.sheetTarget, &ws); err != nil {
+		return nil, err
+	}
+
+	var (
+		records [][]string
+		cols    int
+	)
+	for _, row := range ws.Rows {
+		if row.R > xlsxMaxRows || (row.R > 0 && row.R <= len(records)) {
+			return nil, ErrInvalidCellRef
+		}
+		// rows missing in the sheet are blank
+		for row.R > len(records)+1 {
+			records = append(records, nil)
+		}
+
+		var record []string
+		idx := -1
+		for _, c := range row.Cells {
+			// cells without reference follow the previous cell
+			idx++
+			if c.R != "" {
+				col, err := xlsxColIndex(c.R)
+				if err != nil {
+					return nil, err
+				}
+				idx = col
+			}
+			for len(record) <= idx {
+				record = append(record, "")
+			}
+			val, err := x.cellValue(c)
+			if err != nil {
+				return nil, err
+			}
+			record[idx] = val
+		}
+		if len(record) > cols {
+			cols = len(record)
+		}
+		records = append(records, record)
+	}
+
+	if x.opts.SkipBlankRows {
+		var filled [][]string
+		for _, record := range records {
+			if len(record) > 0 {
+				filled = append(filled, record)
+			}
+		}
+		records = filled
+	}
+
+	return x.dataset(records, cols)
+}
+
+func (x *xlsxTableReader) dataset(records [][]string, cols int) (*Dataset, error) {
+	d := NewDataSet()
+	for idx, record := range records {
+		for len(record) < cols {
+			record = append(record, "")
+		}
+
+		if idx == 0 && x.opts.HasHeaders {
+			for _, title := range record {
+				d.AddHeader(title, title)
+			}
+			continue
+		}
+
+		if err := d.Append(NewRowFromSlice(record)); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func (x *xlsxTableReader) readWorkbook() error {
+	wbPath := xlsxWorkbookPath
+	var rootRels xlsxReadRelationships
+	if err := x.readXML("_rels/.rels", &rootRels); err == nil {
+		for _, rel := range rootRels.Relationships {
+			if rel.Type == xlsxRelOfficeDocument {
+				wbPath = strings.TrimPrefix(rel.Target, "/")
+			}
+		}
+	}
+
+	var wb xlsxReadWorkbook
+	if err := x.readXML(wbPath, &wb); err != nil {
+		return err
+	}
+	x.date1904 = wb.Properties.Date1904
+
+	var wbRels xlsxReadRelationships
+	relsPath := path.Join(path.Dir(wbPath), "_rels", path.Base(wbPath)+".rels")
+	if err := x.readXML(relsPath, &wbRels); err != nil {
+		return err
+	}
+
+	targets := make(map[string]string)
+	for _, rel := range wbRels.Relationships {
+		target := resolveXLSXTarget(wbPath, rel.Target)
+		targets[rel.ID] = target
+
+		switch rel.Type {
+		case xlsxRelSharedStrings:
+			if err := x.readSharedStrings(target); err != nil {
+				return err
+			}
+		case xlsxRelStyles:
+			if err := x.readStyles(target); err != nil {
+				return err
+			}
+		}
+	}
+
+	for idx, sheet := range wb.Sheets {
+		if (x.opts.Sheet != "" && sheet.Name == x.opts.Sheet) || (x.opts.Sheet == "" && idx == x.opts.SheetIndex) {
+			x.sheetTarget = targets[sheet.RelID]
+			return nil
+		}
+	}
+
+	if x.opts.Sheet != "" {
+		return ErrSheetNotFound{x.opts.Sheet}
+	}
+	return ErrSheetNotFound{strconv.Itoa(x.opts.SheetIndex)}
+}
+
+func (x *xlsxTableReader) readSharedStrings(name string) error {
+	var sst xlsxReadSharedStrings
+	if err := x.readXML(name, &sst); err != nil {
+		return err
+	}
+	for _, si := range sst.Items {
+		x.shared = append(x.shared, si.String())
+	}
+	return nil
+}
+
+func (x *xlsxTableReader) readStyles(name string) error {
+	var ss xlsxReadStyleSheet
+	if err := x.readXML(name, &ss); err != nil {
+		return err
+	}
+
+	custom := make(map[int]string)
+	for _, nf := range ss.NumFmts {
+		custom[nf.ID] = nf.Code
+	}
+
+	x.dateStyles = make(map[int]bool)
+	for idx, xf := range ss.CellXfs {
+		if code, ok := custom[xf.NumFmtID]; ok {
+			x.dateStyles[idx] = isDateFormatCode(code)
+		} else {
+			x.dateStyles[idx] = isBuiltinDateFormat(xf.NumFmtID)
+		}
+	}
+	return nil
+}
+
+func (x *xlsxTableReader) readXML(name string, v interface{}) error {
+	for _, f := range x.zr.File {
+		if f.Name != name {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		return xml.NewDecoder(rc).Decode(v)
+	}
+	return fmt.Errorf("xlsx: missing %s", name)
+}
+
+func (x *xlsxTableReader) cellValue(c xlsxReadCell) (string, error) {
+	switch c.T {
+	case "s":
+		idx, err := strconv.Atoi(c.Value)
+		if err != nil || idx < 0 || idx >= len(x.shared) {
+			return "", fmt.Errorf("xlsx: invalid shared string index %q", c.Value)
+		}
+		return x.shared[idx], nil
+	case "inlineStr":
+		return c.Inline.String(), nil
+	case "b":
+		if c.Value == "1" {
+			return "true", nil
+		}
+		return "false", nil
+	case "", "n":
+		if c.Value != "" && x.dateStyles[c.S] {
+			return x.formatDate(c.Value)
+		}
+		return c.Value, nil
+	default:
+		// formula strings, errors and ISO 8601 dates are kept as they are
+		return c.Value, nil
+	}
+}
+
+func (x *xlsxTableReader) formatDate(val string) (string, error) {
+	serial, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return "", err
+	}
+
+	t := xlsxSerialToTime(serial, x.date1904)
+	if serial == math.Trunc(serial) {
+		return t.Format(x.dateLayout()), nil
+	}
+	return t.Format(x.dateTimeLayout()), nil
+}
+
+func (x *xlsxTableReader) dateLayout() string {
+	if x.opts.DateLayout != "" {
+		return x.opts.DateLayout
+	}
+	return defaultDateLayout
+}
+
+func (x *xlsxTableReader) dateTimeLayout() string {
+	if x.opts.DateTimeLayout != "" {
+		return x.opts.DateTimeLayout
+	}
+	return defaultDateTimeLayout
+}
+
+// xlsxSerialToTime converts spreadsheet date serial to time.
+func xlsxSerialToTime(serial float64, date1904 bool) time.Time {
+	var epoch time.Time
+	switch {
+	case date1904:
+		epoch = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+	case serial < 61:
+		// 1900 date system treats 1900 as a leap year, so serials before
+		// the non-existent 1900-02-29 are shifted by one day
+		epoch = time.Date(1899, 12, 31, 0, 0, 0, 0, time.UTC)
+	default:
+		epoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	}
+
+	days := math.Floor(serial)
+	secs := math.Round((serial - days) * 86400)
+	return epoch.AddDate(0, 0, int(days)).Add(time.Duration(secs) * time.Second)
+}
+
+func isBuiltinDateFormat(id int) bool {
+	switch {
+	case id >= 14 && id <= 22:
+		return true
+	case id >= 27 && id <= 36:
+		return true
+	case id >= 45 && id <= 47:
+		return true
+	case id >= 50 && id <= 58:
+		return true
+	}
+	return false
+}
+
+func isDateFormatCode(code string) bool {
+	var (
+		inQuotes bool
+		escaped  bool
+		bracket  *strings.Builder
+	)
+
+	for _, r := range strings.ToLower(code) {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+		case r == '[':
+			bracket = &strings.Builder{}
+		case r == ']' && bracket != nil:
+			// elapsed time sections like [h] or [mm] denote time,
+			// other sections hold colors, conditions or locales
+			if content := bracket.String(); content != "" && strings.Trim(content, "hms") == "" {
+				return true
+			}
+			bracket = nil
+		case bracket != nil:
+			bracket.WriteRune(r)
+		case r == 'd', r == 'm', r == 'y', r == 'h', r == 's':
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	// xlsxMaxCols is the maximum number of spreadsheet columns, last column is XFD.
+	xlsxMaxCols = 16384
+
+	// xlsxMaxRows is the maximum number of spreadsheet rows.
+	xlsxMaxRows = 1048576
+)
+
+// xlsxColIndex returns zero based column index from cell reference.
+func xlsxColIndex(ref string) (int, error) {
+	idx := 0
+	n := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		idx = idx*26 + int(r-'A') + 1
+		if idx > xlsxMaxCols {
+			return 0, ErrInvalidCellRef
+		}
+		n++
+	}
+	if n == 0 {
+		return 0, ErrInvalidCellRef
+	}
+	return idx - 1, nil
+}
+
+func resolveXLSXTarget(base string, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+	return path.Join(path.Dir(base), target)
+}
@@ -0,0 +1,288 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const testXLSXWorkbook = `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<workbookPr date1904="%s"/>
+<sheets>
+<sheet name="Summary" sheetId="1" r:id="rId1"/>
+<sheet name="Details" sheetId="2" r:id="rId2"/>
+</sheets>
+</workbook>`
+
+const testXLSXWorkbookRels = `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="/xl/worksheets/sheet2.xml"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+<Relationship Id="rId4" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="sharedStrings.xml"/>
+</Relationships>`
+
+const testXLSXStyles = `<?xml version="1.0" encoding="UTF-8"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<numFmts count="2">
+<numFmt numFmtId="164" formatCode="[Red]0.00"/>
+<numFmt numFmtId="165" formatCode="dd/mm/yyyy\ hh:mm"/>
+</numFmts>
+<cellXfs count="4">
+<xf numFmtId="0"/>
+<xf numFmtId="14"/>
+<xf numFmtId="164"/>
+<xf numFmtId="165"/>
+</cellXfs>
+</styleSheet>`
+
+const testXLSXSharedStrings = `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>name</t></si>
+<si><t>born</t></si>
+<si><r><t>Ju</t></r><r><rPr><b/></rPr><t>lia</t></r></si>
+</sst>`
+
+const testXLSXSheet1 = `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="inlineStr"><is><t>score</t></is></c><c r="D1" t="inlineStr"><is><t>active</t></is></c></row>
+<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2" s="1"><v>24563</v></c><c r="C2" s="2"><v>12.5</v></c><c r="D2" t="b"><v>1</v></c></row>
+<row r="4"><c r="B4" s="3"><v>24563.75</v></c><c r="D4" t="b"><v>0</v></c></row>
+</sheetData></worksheet>`
+
+const testXLSXSheet2 = `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="str"><v>detail</v></c><c r="B1" t="e"><v>#DIV/0!</v></c></row>
+</sheetData></worksheet>`
+
+type XLSXReaderTestSuite struct {
+	suite.Suite
+}
+
+func (s *XLSXReaderTestSuite) TestReadRoundTrip() {
+	d, err := newTestDataset()
+	s.NoError(err)
+	out, err := newTestWrite(d, NewXLSXWriter(&XLSXOpts{}))
+	s.NoError(err)
+
+	r := NewXLSXReader(&XLSXReaderOpts{
+		HasHeaders: true,
+	})
+	loaded, err := r.Read(strings.NewReader(out))
+	s.NoError(err)
+	s.Equal(d.HeaderCount(), loaded.HeaderCount())
+
+	for idx, hdr := range loaded.Headers() {
+		s.Equal(testHeaders[idx].Title, hdr.Title)
+	}
+	for idx, row := range loaded.Rows() {
+		s.Equal(testRows[idx], row.Items())
+	}
+}
+
+func (s *XLSXReaderTestSuite) TestReadSheetByIndex() {
+	r := NewXLSXReader(&XLSXReaderOpts{
+		HasHeaders: true,
+	})
+	d, err := r.Read(bytes.NewReader(s.newWorkbook(false)))
+	s.NoError(err)
+
+	var keys []string
+	for _, hdr := range d.Headers() {
+		keys = append(keys, hdr.Key)
+	}
+	s.Equal([]string{"name", "born", "score", "active"}, keys)
+	s.Equal(3, d.Len())
+
+	r1, _ := d.Get(0)
+	r2, _ := d.Get(1)
+	r3, _ := d.Get(2)
+	s.Equal([]string{"Julia", "1967-04-01", "12.5", "true"}, r1.Items())
+	s.Equal([]string{"", "", "", ""}, r2.Items())
+	s.Equal([]string{"", "1967-04-01 18:00:00", "", "false"}, r3.Items())
+}
+
+func (s *XLSXReaderTestSuite) TestReadSheetByName() {
+	r := NewXLSXReader(&XLSXReaderOpts{
+		Sheet: "Details",
+	})
+	d, err := r.Read(bytes.NewReader(s.newWorkbook(false)))
+	s.NoError(err)
+	s.False(d.HasHeaders())
+
+	row, _ := d.Get(0)
+	s.Equal([]string{"detail", "#DIV/0!"}, row.Items())
+}
+
+func (s *XLSXReaderTestSuite) TestReadDate1904() {
+	r := NewXLSXReader(&XLSXReaderOpts{
+		HasHeaders:     true,
+		DateLayout:     "02.01.2006",
+		DateTimeLayout: time.RFC3339,
+	})
+	d, err := r.Read(bytes.NewReader(s.newWorkbook(true)))
+	s.NoError(err)
+	s.Equal([]string{"02.04.1971", "", "1971-04-02T18:00:00Z"}, d.GetColValues("born"))
+}
+
+func (s *XLSXReaderTestSuite) TestReadSheetNotFound() {
+	r := NewXLSXReader(&XLSXReaderOpts{
+		Sheet: "Missing",
+	})
+	_, err := r.Read(bytes.NewReader(s.newWorkbook(false)))
+	s.Equal(ErrSheetNotFound{"Missing"}, err)
+
+	r = NewXLSXReader(&XLSXReaderOpts{
+		SheetIndex: 5,
+	})
+	_, err = r.Read(bytes.NewReader(s.newWorkbook(false)))
+	s.Equal(ErrSheetNotFound{"5"}, err)
+}
+
+func (s *XLSXReaderTestSuite) TestSerialToTime() {
+	s.Equal(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), xlsxSerialToTime(1, false))
+	s.Equal(time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC), xlsxSerialToTime(59, false))
+	s.Equal(time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC), xlsxSerialToTime(61, false))
+	s.Equal(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC), xlsxSerialToTime(43831.5, false))
+	s.Equal(time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC), xlsxSerialToTime(0, true))
+}
+
+func (s *XLSXReaderTestSuite) TestDateFormatCode() {
+	s.True(isDateFormatCode("yyyy-mm-dd"))
+	s.True(isDateFormatCode("[h]:mm:ss"))
+	s.True(isDateFormatCode("[$-409]d-mmm-yy;@"))
+	s.False(isDateFormatCode("General"))
+	s.False(isDateFormatCode("[Magenta]#,##0.00"))
+	s.False(isDateFormatCode(`0.00 "days"`))
+	s.False(isDateFormatCode(`0\d`))
+}
+
+func (s *XLSXReaderTestSuite) TestColIndex() {
+	idx, err := xlsxColIndex("A1")
+	s.NoError(err)
+	s.Equal(0, idx)
+
+	idx, err = xlsxColIndex("AB12")
+	s.NoError(err)
+	s.Equal(27, idx)
+
+	idx, err = xlsxColIndex("XFD1")
+	s.NoError(err)
+	s.Equal(16383, idx)
+
+	_, err = xlsxColIndex("12")
+	s.Equal(ErrInvalidCellRef, err)
+
+	_, err = xlsxColIndex("XFE1")
+	s.Equal(ErrInvalidCellRef, err)
+
+	_, err = xlsxColIndex("ZZZZZZZZZZZZZZZ6")
+	s.Equal(ErrInvalidCellRef, err)
+}
+
+const testXLSXSheetGaps = `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="str"><v>a</v></c></row>
+<row r="5"><c r="A5" t="str"><v>b</v></c><c t="str"><v>c</v></c></row>
+<row r="6"><c r="B6" t="str"><v>d</v></c><c t="str"><v>e</v></c></row>
+<row><c t="str"><v>f</v></c></row>
+</sheetData></worksheet>`
+
+func (s *XLSXReaderTestSuite) TestReadBlankRows() {
+	r := NewXLSXReader(&XLSXReaderOpts{})
+	d, err := r.Read(bytes.NewReader(s.newWorkbookWith(false, testXLSXSheetGaps)))
+	s.NoError(err)
+	s.Equal(7, d.Len())
+	s.Equal([]string{"a", "", "", "", "b", "", "f"}, s.firstColumn(d))
+
+	r5, _ := d.Get(4)
+	r6, _ := d.Get(5)
+	s.Equal([]string{"b", "c", ""}, r5.Items())
+	s.Equal([]string{"", "d", "e"}, r6.Items())
+}
+
+func (s *XLSXReaderTestSuite) firstColumn(d *Dataset) []string {
+	var col []string
+	for _, row := range d.Rows() {
+		col = append(col, row.Get(0))
+	}
+	return col
+}
+
+func (s *XLSXReaderTestSuite) TestReadSkipBlankRows() {
+	r := NewXLSXReader(&XLSXReaderOpts{
+		SkipBlankRows: true,
+	})
+	d, err := r.Read(bytes.NewReader(s.newWorkbookWith(false, testXLSXSheetGaps)))
+	s.NoError(err)
+	s.Equal(4, d.Len())
+	s.Equal([]string{"a", "b", "", "f"}, s.firstColumn(d))
+}
+
+func (s *XLSXReaderTestSuite) TestReadInvalidRowNumber() {
+	r := NewXLSXReader(&XLSXReaderOpts{})
+	_, err := r.Read(bytes.NewReader(s.newWorkbookWith(false, `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="2"><c r="A2" t="str"><v>a</v></c></row>
+<row r="1"><c r="A1" t="str"><v>b</v></c></row>
+</sheetData></worksheet>`)))
+	s.Equal(ErrInvalidCellRef, err)
+
+	_, err = r.Read(bytes.NewReader(s.newWorkbookWith(false, `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1048577"><c r="A1048577" t="str"><v>a</v></c></row>
+</sheetData></worksheet>`)))
+	s.Equal(ErrInvalidCellRef, err)
+}
+
+func (s *XLSXReaderTestSuite) TestReadColumnOutOfRange() {
+	r := NewXLSXReader(&XLSXReaderOpts{})
+	_, err := r.Read(bytes.NewReader(s.newWorkbookWith(false, `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="6"><c r="ZZZZ6" t="str"><v>a</v></c></row>
+</sheetData></worksheet>`)))
+	s.Equal(ErrInvalidCellRef, err)
+}
+
+func (s *XLSXReaderTestSuite) newWorkbook(date1904 bool) []byte {
+	return s.newWorkbookWith(date1904, testXLSXSheet1)
+}
+
+func (s *XLSXReaderTestSuite) newWorkbookWith(date1904 bool, sheet string) []byte {
+	epoch := "0"
+	if date1904 {
+		epoch = "1"
+	}
+
+	files := []struct {
+		name string
+		body string
+	}{
+		{"xl/workbook.xml", strings.Replace(testXLSXWorkbook, "%s", epoch, 1)},
+		{"xl/_rels/workbook.xml.rels", testXLSXWorkbookRels},
+		{"xl/styles.xml", testXLSXStyles},
+		{"xl/sharedStrings.xml", testXLSXSharedStrings},
+		{"xl/worksheets/sheet1.xml", sheet},
+		{"xl/worksheets/sheet2.xml", testXLSXSheet2},
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		s.Require().NoError(err)
+		_, err = fw.Write([]byte(f.body))
+		s.Require().NoError(err)
+	}
+	s.Require().NoError(zw.Close())
+	return buf.Bytes()
+}
+
+func TestXLSXReaderTestSuite(t *testing.T) {
+	suite.Run(t, new(XLSXReaderTestSuite))
+}
@@ -0,0 +1,140 @@
+package tabular
+
+// Melt column names used by Melt.
+const (
+	MeltVariableKey = "variable"
+	MeltValueKey    = "value"
+)
+
+// Pivot returns new wide dataset with one row per distinct value of rowKey
+// and one column per distinct value of columnKey, both in order of first
+// occurrence. Cells hold values of valueKey aggregated by agg, missing
+// combinations are filled with fill. Rows with null value of columnKey are
+// left out.
+func (d *Dataset) Pivot(rowKey string, columnKey string, valueKey string,
+	agg Aggregator, fill string) (*Dataset, error) {
+	valueIdx, err := d.columnIndex(valueKey)
+	if err != nil {
+		return nil, err
+	}
+	g, err := d.GroupBy(rowKey, columnKey)
+	if err != nil {
+		return nil, err
+	}
+
+	res := NewDataSet()
+	hdr, _ := d.GetHeader(g.idxs[0])
+	res.AddTypedHeader(hdr.Key, hdr.Title, hdr.Type, hdr.Nullable)
+
+	var rows []*Row
+	rowIndex := make(map[string]int)
+	colIndex := make(map[string]int)
+	for _, group := range g.groups {
+		if group.nulls[1] {
+			continue
+		}
+
+		col := group.Values[1]
+		cpos, ok := colIndex[col]
+		if !ok {
+			if res.HasCol(col) {
+				return nil, ErrDuplicateColumn{col}
+			}
+			cpos = res.HeaderCount()
+			colIndex[col] = cpos
+			res.AddHeader(col, col)
+		}
+
+		key := d.groupKey(group.Dataset.rows[0], g.idxs[:1])
+		rpos, ok := rowIndex[key]
+		if !ok {
+			rpos = len(rows)
+			rowIndex[key] = rpos
+			row := NewRow()
+			if group.nulls[0] {
+				row.AddNull()
+			} else {
+				row.Add(group.Values[0])
+			}
+			rows = append(rows, row)
+		}
+
+		val, err := agg(group.values(valueIdx))
+		if err != nil {
+			return nil, ErrAggregate{
+				key: col,
+				err: err,
+			}
+		}
+
+		row := rows[rpos]
+		for row.Len() <= cpos {
+			row.Add(fill)
+		}
+		row.Set(cpos, val)
+	}
+
+	for _, row := range rows {
+		for row.Len() < res.HeaderCount() {
+			row.Add(fill)
+		}
+		if err := res.Append(row); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Melt returns new long dataset with idKeys columns followed by variable
+// column holding keys of valueKeys columns and value column holding their
+// values. All columns except idKeys are melted when valueKeys is empty.
+func (d *Dataset) Melt(idKeys []string, valueKeys []string) (*Dataset, error) {
+	idIdxs, err := d.columnIndexesOf(idKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(valueKeys) == 0 {
+		ids := newStringSet()
+		for _, key := range idKeys {
+			ids.Add(key)
+		}
+		for _, hdr := range d.Headers() {
+			if !ids.Contains(hdr.Key) {
+				valueKeys = append(valueKeys, hdr.Key)
+			}
+		}
+	}
+	valueIdxs, err := d.columnIndexesOf(valueKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	res := NewDataSet()
+	for _, idx := range idIdxs {
+		hdr, _ := d.GetHeader(idx)
+		res.AddTypedHeader(hdr.Key, hdr.Title, hdr.Type, hdr.Nullable)
+	}
+	for _, key := range []string{MeltVariableKey, MeltValueKey} {
+		if res.HasCol(key) {
+			return nil, ErrDuplicateColumn{key}
+		}
+		res.AddHeader(key, key)
+	}
+
+	for _, row := range d.rows {
+		for i, idx := range valueIdxs {
+			nr := row.project(idIdxs)
+			nr.Add(valueKeys[i])
+			if d.isNull(row, idx) {
+				nr.AddNull()
+			} else {
+				nr.Add(row.Get(idx))
+			}
+			if err := res.Append(nr); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,127 @@
+package tabular
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ReshapeTestSuite struct {
+	suite.Suite
+}
+
+func (s *ReshapeTestSuite) newDataset() *Dataset {
+	d := NewDataSet()
+	d.AddHeader("region", "Region")
+	d.AddHeader("month", "Month")
+	d.AddHeader("revenue", "Revenue")
+
+	s.Require().NoError(d.Append(
+		NewRow("north", "2020-02", "10"),
+		NewRow("south", "2020-01", "5"),
+		NewRow("north", "2020-01", "20"),
+		NewRow("north", "2020-02", "15"),
+		NewRow("east", "2020-03", "7"),
+	))
+	return d
+}
+
+func (s *ReshapeTestSuite) TestPivot() {
+	d := s.newDataset()
+
+	res, err := d.Pivot("region", "month", "revenue", AggSum, "0")
+	s.NoError(err)
+
+	var keys []string
+	for _, hdr := range res.Headers() {
+		keys = append(keys, hdr.Key)
+	}
+	s.Equal([]string{"region", "2020-02", "2020-01", "2020-03"}, keys)
+	hdr, _ := res.GetHeader(0)
+	s.Equal("Region", hdr.Title)
+
+	r1, _ := res.Get(0)
+	r2, _ := res.Get(1)
+	r3, _ := res.Get(2)
+	s.Equal([]string{"north", "25", "20", "0"}, r1.Items())
+	s.Equal([]string{"south", "0", "5", "0"}, r2.Items())
+	s.Equal([]string{"east", "0", "0", "7"}, r3.Items())
+	s.Equal(7, res.GetIdxWidth(1))
+}
+
+func (s *ReshapeTestSuite) TestPivotNulls() {
+	d := s.newDataset()
+	r := NewRow("west", "", "1")
+	r.SetNull(1)
+	s.NoError(d.Append(r))
+
+	res, err := d.Pivot("region", "month", "revenue", AggFirst, "")
+	s.NoError(err)
+	s.Equal(3, res.Len())
+	s.Equal(4, res.HeaderCount())
+	s.Equal([]string{"10", "", ""}, res.GetColValues("2020-02"))
+}
+
+func (s *ReshapeTestSuite) TestPivotErrors() {
+	d := s.newDataset()
+
+	_, err := d.Pivot("region", "month", "missing", AggSum, "")
+	s.Equal(ErrColumnNotFound{"missing"}, err)
+
+	_, err = d.Pivot("missing", "month", "revenue", AggSum, "")
+	s.Equal(ErrColumnNotFound{"missing"}, err)
+
+	_, err = d.Pivot("month", "region", "region", AggSum, "")
+	s.IsType(ErrAggregate{}, err)
+
+	s.NoError(d.Append(NewRow("north", "region", "1")))
+	_, err = d.Pivot("region", "month", "revenue", AggSum, "")
+	s.Equal(ErrDuplicateColumn{"region"}, err)
+}
+
+func (s *ReshapeTestSuite) TestMelt() {
+	d, err := s.newDataset().Pivot("region", "month", "revenue", AggSum, "")
+	s.NoError(err)
+	r, _ := d.Get(1)
+	r.SetNull(1)
+
+	res, err := d.Melt([]string{"region"}, []string{"2020-01", "2020-02"})
+	s.NoError(err)
+
+	var keys []string
+	for _, hdr := range res.Headers() {
+		keys = append(keys, hdr.Key)
+	}
+	s.Equal([]string{"region", MeltVariableKey, MeltValueKey}, keys)
+	s.Equal([]string{"north", "north", "south", "south", "east", "east"}, res.GetColValues("region"))
+	s.Equal([]string{"2020-01", "2020-02", "2020-01", "2020-02", "2020-01", "2020-02"}, res.GetColValues("variable"))
+	s.Equal([]string{"20", "25", "5", "", "", ""}, res.GetColValues("value"))
+
+	row, _ := res.Get(3)
+	s.True(row.IsNull(2))
+	row, _ = res.Get(4)
+	s.False(row.IsNull(2))
+
+	res, err = d.Melt([]string{"region"}, nil)
+	s.NoError(err)
+	s.Equal(9, res.Len())
+	s.Equal([]string{"2020-02", "2020-01", "2020-03"}, res.GetColValues("variable")[:3])
+}
+
+func (s *ReshapeTestSuite) TestMeltErrors() {
+	d := s.newDataset()
+
+	_, err := d.Melt([]string{"missing"}, nil)
+	s.Equal(ErrColumnNotFound{"missing"}, err)
+
+	_, err = d.Melt([]string{"region"}, []string{"missing"})
+	s.Equal(ErrColumnNotFound{"missing"}, err)
+
+	s.NoError(d.RenameColumn("month", "value"))
+	_, err = d.Melt([]string{"value"}, []string{"revenue"})
+	s.Equal(ErrDuplicateColumn{"value"}, err)
+}
+
+func TestReshapeTestSuite(t *testing.T) {
+	suite.Run(t, new(ReshapeTestSuite))
+}
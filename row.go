@@ -16,6 +16,7 @@ func NewRowFromSlice(items []string) *Row {
 // Row represents a row of dataset.
 type Row struct {
 	items  []string
+	nulls  map[int]bool
 	tagger Tagger
 }
 
@@ -24,16 +25,78 @@ func (r *Row) Add(items ...string) {
 	r.items = append(r.items, items...)
 }
 
+// AddNull appends new null item to the row.
+func (r *Row) AddNull() {
+	r.items = append(r.items, "")
+	r.markNull(len(r.items) - 1)
+}
+
 // Get returns tow item on given index .
 func (r *Row) Get(idx int) string {
 	return r.items[idx]
 }
 
+// Set replaces item on given index, null mark of the item is cleared.
+func (r *Row) Set(idx int, val string) {
+	r.items[idx] = val
+	delete(r.nulls, idx)
+}
+
+// SetNull marks item on given index as null, its value is reset to empty string.
+func (r *Row) SetNull(idx int) {
+	r.items[idx] = ""
+	r.markNull(idx)
+}
+
+// IsNull checks if item on given index is null.
+func (r *Row) IsNull(idx int) bool {
+	return r.nulls[idx]
+}
+
+func (r *Row) markNull(idx int) {
+	if r.nulls == nil {
+		r.nulls = make(map[int]bool)
+	}
+	r.nulls[idx] = true
+}
+
 // Items returns slice of row items.
 func (r *Row) Items() []string {
 	return r.items
 }
 
+// Clone returns a copy of the row including null marks and tags.
+func (r *Row) Clone() *Row {
+	items := make([]string, len(r.items))
+	copy(items, r.items)
+
+	c := NewRowFromSlice(items)
+	for idx := range r.nulls {
+		c.markNull(idx)
+	}
+	for _, tag := range r.Tags() {
+		c.AddTag(tag)
+	}
+	return c
+}
+
+// project returns new row holding items on given indexes, null marks and
+// tags are kept. Negative index adds null item.
+func (r *Row) project(idxs []int) *Row {
+	p := NewRow()
+	for _, idx := range idxs {
+		if idx < 0 || r.IsNull(idx) {
+			p.AddNull()
+		} else {
+			p.Add(r.items[idx])
+		}
+	}
+	for _, tag := range r.Tags() {
+		p.AddTag(tag)
+	}
+	return p
+}
+
 // Len returns row item count.
 func (r *Row) Len() int {
 	return len(r.items)
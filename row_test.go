@@ -82,6 +82,24 @@ func (s *RowTestSuite) TestTagsItems() {
 	s.Equal(expected, tags)
 }
 
+func (s *RowTestSuite) TestNull() {
+	r := NewRow("a", "b")
+	r.AddNull()
+	s.Equal([]string{"a", "b", ""}, r.Items())
+	s.False(r.IsNull(0))
+	s.True(r.IsNull(2))
+
+	r.SetNull(0)
+	s.True(r.IsNull(0))
+	s.Equal("", r.Get(0))
+
+	r.Set(0, "")
+	s.False(r.IsNull(0))
+
+	r.Set(1, "c")
+	s.Equal("c", r.Get(1))
+}
+
 func TestRowTestSuite(t *testing.T) {
 	suite.Run(t, new(RowTestSuite))
 }
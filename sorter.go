@@ -2,6 +2,12 @@ package tabular
 
 import (
 	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
 )
 
 // RowSorter implements a row sorter.
@@ -30,8 +36,304 @@ func (r RowSorter) Less(i, j int) bool {
 	return r.rows[i].Get(r.idx) < r.rows[j].Get(r.idx)
 }
 
-// Sort sorts rows and returns sorted slice.
+// Sort sorts rows and returns sorted slice, order of equal rows is kept.
 func (r RowSorter) Sort() []*Row {
-	sort.Sort(r)
+	sort.Stable(r)
 	return r.rows
 }
+
+// SortMode represents the way values are compared when sorting.
+type SortMode int
+
+const (
+	// SortDefault compares values of typed columns as native values and
+	// values of untyped columns lexically.
+	SortDefault SortMode = iota
+
+	// SortLexical compares values byte by byte.
+	SortLexical
+
+	// SortNumeric compares values as numbers.
+	SortNumeric
+
+	// SortNatural compares digit sequences as numbers, so file2 sorts before file10.
+	SortNatural
+
+	// SortCaseInsensitive compares values lexically ignoring case.
+	SortCaseInsensitive
+
+	// SortDate compares values as times parsed using layout of the sort key.
+	SortDate
+)
+
+// SortKey represents a column used by SortBy.
+type SortKey struct {
+	Key  string
+	Desc bool
+	Mode SortMode
+
+	// Layout is the time layout used by SortDate, RFC 3339, 2006-01-02 15:04:05
+	// and 2006-01-02 layouts are tried when empty.
+	Layout string
+
+	// Collation overrides Mode with locale-aware comparison of text.
+	Collation *Collation
+
+	// Compare overrides Mode and Collation with custom comparator returning
+	// negative number, zero or positive number when a is less, equal or
+	// greater than b.
+	Compare func(a string, b string) int
+
+	// NullsFirst places empty and null values first, they are placed last
+	// by default regardless of Desc.
+	NullsFirst bool
+}
+
+// Collation represents Unicode collation rules of a language.
+type Collation struct {
+	// Language is BCP 47 language tag, for example cs or de-AT.
+	Language string
+
+	// IgnoreCase makes letters differing only in case equal.
+	IgnoreCase bool
+
+	// IgnoreAccents makes letters differing only in diacritics equal.
+	IgnoreAccents bool
+}
+
+// comparator returns comparator of text using the collation. Collators
+// are not safe for concurrent use, new one is created for every sort.
+func (c *Collation) comparator() (func(a string, b string) int, error) {
+	tag, err := language.Parse(c.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []collate.Option
+	if c.IgnoreCase {
+		opts = append(opts, collate.IgnoreCase)
+	}
+	if c.IgnoreAccents {
+		opts = append(opts, collate.IgnoreDiacritics)
+	}
+	return collate.New(tag, opts...).CompareString, nil
+}
+
+// SortBy returns new dataset sorted by keys, rows equal by the first key are
+// ordered by the next one. Sorting is stable, order of equal rows is kept.
+// Values which can not be parsed by numeric and date modes are placed after
+// parsed values regardless of Desc and compared lexically.
+func (d *Dataset) SortBy(keys ...SortKey) (*Dataset, error) {
+	sorters := make([]keySorter, 0, len(keys))
+	for _, key := range keys {
+		idx, ok := d.getColumnIndex(key.Key)
+		if !ok {
+			return nil, ErrColumnNotFound{key.Key}
+		}
+		hdr, _ := d.GetHeader(idx)
+		compare, err := key.comparator(hdr.Type)
+		if err != nil {
+			return nil, err
+		}
+		sorters = append(sorters, keySorter{
+			key:     key,
+			idx:     idx,
+			compare: compare,
+			parses:  key.parser(),
+		})
+	}
+
+	rows := make([]*Row, len(d.rows))
+	copy(rows, d.rows)
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, ks := range sorters {
+			if res := ks.cmp(d, rows[i], rows[j]); res != 0 {
+				return res < 0
+			}
+		}
+		return false
+	})
+	return d.derive(rows), nil
+}
+
+type keySorter struct {
+	key     SortKey
+	idx     int
+	compare func(a string, b string) int
+
+	// parses reports whether value can be parsed by numeric and date modes
+	parses func(s string) bool
+}
+
+func (ks keySorter) cmp(d *Dataset, r1 *Row, r2 *Row) int {
+	a, b := r1.Get(ks.idx), r2.Get(ks.idx)
+	nulla := a == "" || d.isNull(r1, ks.idx)
+	nullb := b == "" || d.isNull(r2, ks.idx)
+
+	switch {
+	case nulla && nullb:
+		return 0
+	case nulla != nullb:
+		if nulla == ks.key.NullsFirst {
+			return -1
+		}
+		return 1
+	}
+
+	// unparsed values are placed last regardless of Desc like nulls
+	if ks.parses != nil {
+		if oka, okb := ks.parses(a), ks.parses(b); oka != okb {
+			if oka {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	res := ks.compare(a, b)
+	if ks.key.Desc {
+		return -res
+	}
+	return res
+}
+
+func (k SortKey) comparator(typ ColumnType) (func(a string, b string) int, error) {
+	if k.Compare != nil {
+		return k.Compare, nil
+	}
+	if k.Collation != nil {
+		return k.Collation.comparator()
+	}
+	return k.modeComparator(typ), nil
+}
+
+// parser returns function reporting whether value can be parsed by numeric
+// and date modes, nil is returned for other modes.
+func (k SortKey) parser() func(s string) bool {
+	if k.Compare != nil || k.Collation != nil {
+		return nil
+	}
+
+	switch k.Mode {
+	case SortNumeric:
+		return func(s string) bool {
+			_, err := strconv.ParseFloat(s, 64)
+			return err == nil
+		}
+	case SortDate:
+		return func(s string) bool {
+			_, err := k.parseDate(s)
+			return err == nil
+		}
+	}
+	return nil
+}
+
+func (k SortKey) modeComparator(typ ColumnType) func(a string, b string) int {
+	switch k.Mode {
+	case SortLexical:
+		return strings.Compare
+	case SortNumeric:
+		return compareNumeric
+	case SortNatural:
+		return compareNatural
+	case SortCaseInsensitive:
+		return func(a string, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		}
+	case SortDate:
+		return k.compareDates
+	default:
+		if typ == TypeAny || typ == TypeString {
+			return strings.Compare
+		}
+		return func(a string, b string) int {
+			return compareValues(typ, a, b)
+		}
+	}
+}
+
+func compareNumeric(a string, b string) int {
+	x, errx := strconv.ParseFloat(a, 64)
+	y, erry := strconv.ParseFloat(b, 64)
+	return compareParsed(errx == nil, erry == nil, a, b, func() int {
+		return compareFloats(x, y)
+	})
+}
+
+func (k SortKey) parseDate(s string) (time.Time, error) {
+	if k.Layout != "" {
+		return time.Parse(k.Layout, s)
+	}
+	return parseTime(s)
+}
+
+func (k SortKey) compareDates(a string, b string) int {
+	x, errx := k.parseDate(a)
+	y, erry := k.parseDate(b)
+	return compareParsed(errx == nil, erry == nil, a, b, func() int {
+		return compareTimes(x, y)
+	})
+}
+
+// compareParsed compares parsed values using fn, values which failed to
+// parse are ordered after parsed ones and compared lexically.
+func compareParsed(oka bool, okb bool, a string, b string, fn func() int) int {
+	switch {
+	case oka && okb:
+		return fn()
+	case oka:
+		return -1
+	case okb:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// compareNatural compares runs of digits numerically and other parts lexically.
+func compareNatural(a string, b string) int {
+	for a != "" && b != "" {
+		ca, ra := naturalChunk(a)
+		cb, rb := naturalChunk(b)
+
+		var res int
+		if isDigit(ca[0]) && isDigit(cb[0]) {
+			res = compareDigits(ca, cb)
+		} else {
+			res = strings.Compare(ca, cb)
+		}
+		if res != 0 {
+			return res
+		}
+		a, b = ra, rb
+	}
+	return compareFloats(float64(len(a)), float64(len(b)))
+}
+
+// naturalChunk splits leading run of digits or non-digits from s.
+func naturalChunk(s string) (string, string) {
+	digit := isDigit(s[0])
+	i := 1
+	for i < len(s) && isDigit(s[i]) == digit {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// compareDigits compares digit runs of arbitrary length as numbers, runs
+// with more leading zeros are ordered first when equal.
+func compareDigits(a string, b string) int {
+	ta, tb := strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	if len(ta) != len(tb) {
+		return compareFloats(float64(len(ta)), float64(len(tb)))
+	}
+	if res := strings.Compare(ta, tb); res != 0 {
+		return res
+	}
+	return compareFloats(float64(len(b)), float64(len(a)))
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,156 @@
+package tabular
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SorterTestSuite struct {
+	suite.Suite
+}
+
+func (s *SorterTestSuite) newDataset() *Dataset {
+	d := NewDataSet()
+	d.AddHeader("name", "Name")
+	d.AddHeader("file", "File")
+	d.AddHeader("size", "Size")
+	d.AddHeader("date", "Date")
+
+	s.Require().NoError(d.Append(
+		NewRow("julia", "file10", "9", "02.01.2020"),
+		NewRow("Bill", "file2", "10", "01.02.2019"),
+		NewRow("john", "file02", "", "15.06.2020"),
+		NewRow("bill", "file1", "1.5", "n/a"),
+	))
+	r := NewRow("anna", "", "", "")
+	r.SetNull(1)
+	s.Require().NoError(d.Append(r))
+	return d
+}
+
+func (s *SorterTestSuite) TestSortByModes() {
+	d := s.newDataset()
+
+	cases := []struct {
+		key      SortKey
+		col      string
+		expected []string
+	}{
+		{SortKey{Key: "name"}, "name", []string{"Bill", "anna", "bill", "john", "julia"}},
+		{SortKey{Key: "name", Mode: SortCaseInsensitive}, "name", []string{"anna", "Bill", "bill", "john", "julia"}},
+		{SortKey{Key: "file", Mode: SortLexical}, "file", []string{"file02", "file1", "file10", "file2", ""}},
+		{SortKey{Key: "file", Mode: SortNatural}, "file", []string{"file1", "file02", "file2", "file10", ""}},
+		{SortKey{Key: "size", Mode: SortNumeric}, "size", []string{"1.5", "9", "10", "", ""}},
+		{SortKey{Key: "size", Mode: SortNumeric, Desc: true}, "size", []string{"10", "9", "1.5", "", ""}},
+		{SortKey{Key: "size", Mode: SortNumeric, NullsFirst: true}, "name", []string{"john", "anna", "bill", "julia", "Bill"}},
+		{SortKey{Key: "date", Mode: SortDate, Layout: "02.01.2006"}, "date", []string{"01.02.2019", "02.01.2020", "15.06.2020", "n/a", ""}},
+		{SortKey{Key: "date", Mode: SortDate, Layout: "02.01.2006", Desc: true}, "date", []string{"15.06.2020", "02.01.2020", "01.02.2019", "n/a", ""}},
+		{SortKey{Key: "name", Compare: func(a, b string) int {
+			return len(a) - len(b)
+		}}, "name", []string{"Bill", "john", "bill", "anna", "julia"}},
+	}
+	for _, c := range cases {
+		sorted, err := d.SortBy(c.key)
+		s.NoError(err)
+		s.Equal(c.expected, sorted.GetColValues(c.col), c.key.Key)
+	}
+	s.Equal("julia", d.GetColValues("name")[0])
+}
+
+func (s *SorterTestSuite) TestSortByMultipleKeys() {
+	d := s.newDataset()
+
+	sorted, err := d.SortBy(
+		SortKey{Key: "name", Mode: SortCaseInsensitive, Desc: true},
+		SortKey{Key: "size", Mode: SortNumeric},
+	)
+	s.NoError(err)
+	s.Equal([]string{"julia", "john", "bill", "Bill", "anna"}, sorted.GetColValues("name"))
+}
+
+func (s *SorterTestSuite) TestSortByTyped() {
+	d, err := newTestTypedDataset()
+	s.NoError(err)
+	s.NoError(d.Append(NewRow("Bill", "7", "1.8", "true", "1950-09-21", "900")))
+
+	sorted, err := d.SortBy(SortKey{Key: "age"})
+	s.NoError(err)
+	s.Equal([]string{"Bill", "Julia", "007"}, sorted.GetColValues("name"))
+
+	sorted, err = d.SortBy(SortKey{Key: "salary", Desc: true})
+	s.NoError(err)
+	s.Equal([]string{"Julia", "Bill", "007"}, sorted.GetColValues("name"))
+}
+
+func (s *SorterTestSuite) TestSortByUnparsedDesc() {
+	d := NewDataSet()
+	d.AddHeader("size", "Size")
+	s.Require().NoError(d.Append(NewRow("n/a"), NewRow("5"), NewRow("x"), NewRow("1")))
+
+	sorted, err := d.SortBy(SortKey{Key: "size", Mode: SortNumeric, Desc: true})
+	s.NoError(err)
+	s.Equal([]string{"5", "1", "x", "n/a"}, sorted.GetColValues("size"))
+
+	sorted, err = d.SortBy(SortKey{Key: "size", Mode: SortNumeric})
+	s.NoError(err)
+	s.Equal([]string{"1", "5", "n/a", "x"}, sorted.GetColValues("size"))
+}
+
+func (s *SorterTestSuite) TestSortByTypedIntPrecision() {
+	d := NewDataSet()
+	d.AddTypedHeader("id", "ID", TypeInt, false)
+	s.Require().NoError(d.Append(NewRow("9007199254740993"), NewRow("9007199254740992")))
+
+	sorted, err := d.SortBy(SortKey{Key: "id"})
+	s.NoError(err)
+	s.Equal([]string{"9007199254740992", "9007199254740993"}, sorted.GetColValues("id"))
+}
+
+func (s *SorterTestSuite) TestSortByMissingColumn() {
+	d := s.newDataset()
+
+	_, err := d.SortBy(SortKey{Key: "missing"})
+	s.Equal(ErrColumnNotFound{"missing"}, err)
+}
+
+func (s *SorterTestSuite) TestSortByCollation() {
+	d := NewDataSet()
+	d.AddHeader("name", "Name")
+	for _, name := range []string{"Zeman", "Čapek", "Chalupa", "Havel", "Cibulka", "čapek", "Capek"} {
+		s.NoError(d.Append(NewRow(name)))
+	}
+
+	sorted, err := d.SortBy(SortKey{Key: "name"})
+	s.NoError(err)
+	s.Equal([]string{"Capek", "Chalupa", "Cibulka", "Havel", "Zeman", "Čapek", "čapek"}, sorted.GetColValues("name"))
+
+	sorted, err = d.SortBy(SortKey{Key: "name", Collation: &Collation{Language: "cs"}})
+	s.NoError(err)
+	s.Equal([]string{"Capek", "Cibulka", "čapek", "Čapek", "Havel", "Chalupa", "Zeman"}, sorted.GetColValues("name"))
+
+	sorted, err = d.SortBy(SortKey{Key: "name", Collation: &Collation{Language: "cs", IgnoreCase: true}})
+	s.NoError(err)
+	s.Equal([]string{"Capek", "Cibulka", "Čapek", "čapek", "Havel", "Chalupa", "Zeman"}, sorted.GetColValues("name"))
+
+	sorted, err = d.SortBy(SortKey{Key: "name", Collation: &Collation{Language: "de", IgnoreCase: true, IgnoreAccents: true}})
+	s.NoError(err)
+	s.Equal([]string{"Čapek", "čapek", "Capek", "Chalupa", "Cibulka", "Havel", "Zeman"}, sorted.GetColValues("name"))
+
+	_, err = d.SortBy(SortKey{Key: "name", Collation: &Collation{Language: "not a language"}})
+	s.Error(err)
+}
+
+func (s *SorterTestSuite) TestCompareNatural() {
+	s.Equal(-1, compareNatural("a2", "a10"))
+	s.Equal(1, compareNatural("a10b", "a10a"))
+	s.Equal(-1, compareNatural("a", "a1"))
+	s.Equal(-1, compareNatural("007", "7"))
+	s.Equal(0, compareNatural("x1y", "x1y"))
+	s.Equal(-1, compareNatural(strings.Repeat("9", 30), "1"+strings.Repeat("0", 30)))
+}
+
+func TestSorterTestSuite(t *testing.T) {
+	suite.Run(t, new(SorterTestSuite))
+}
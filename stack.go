@@ -0,0 +1,142 @@
+package tabular
+
+// Stack returns new dataset with rows of d followed by rows of others.
+// Columns of others are matched to columns of d by key, datasets without
+// headers are stacked by position.
+func (d *Dataset) Stack(others ...*Dataset) (*Dataset, error) {
+	res := d.derive(nil)
+	if err := res.appendRows(d, nil); err != nil {
+		return nil, err
+	}
+
+	for _, other := range others {
+		idxs, err := d.stackIndexes(other)
+		if err != nil {
+			return nil, err
+		}
+		if err := res.appendRows(other, idxs); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// UnionByName returns new dataset with rows of d followed by rows of others
+// having union of their columns in order of first occurrence. Values of
+// columns missing in a dataset are null.
+func (d *Dataset) UnionByName(others ...*Dataset) (*Dataset, error) {
+	all := append([]*Dataset{d}, others...)
+
+	res := NewDataSet()
+	for _, ds := range all {
+		if !ds.HasHeaders() {
+			return nil, ErrNoHeaders
+		}
+		for _, hdr := range ds.Headers() {
+			if !res.HasCol(hdr.Key) {
+				res.AddTypedHeader(hdr.Key, hdr.Title, hdr.Type, hdr.Nullable)
+			}
+		}
+	}
+
+	// columns missing in any of the datasets hold nulls
+	for _, hdr := range res.Headers() {
+		for _, ds := range all {
+			if !ds.HasCol(hdr.Key) {
+				hdr.Nullable = true
+			}
+		}
+	}
+
+	for _, ds := range all {
+		idxs := make([]int, 0, res.HeaderCount())
+		for _, hdr := range res.Headers() {
+			idx, ok := ds.getColumnIndex(hdr.Key)
+			if !ok {
+				idx = -1
+			}
+			idxs = append(idxs, idx)
+		}
+		if err := res.appendRows(ds, idxs); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// StackColumns returns new dataset with columns of d followed by columns of
+// others, all datasets must have the same number of rows.
+func (d *Dataset) StackColumns(others ...*Dataset) (*Dataset, error) {
+	res := d.derive(nil)
+	for _, other := range others {
+		if other.Len() != d.Len() {
+			return nil, ErrInvalidColumnLength{
+				actual:   other.Len(),
+				expected: d.Len(),
+			}
+		}
+		if other.HasHeaders() != d.HasHeaders() {
+			return nil, ErrNoHeaders
+		}
+		for _, hdr := range other.Headers() {
+			if res.HasCol(hdr.Key) {
+				return nil, ErrDuplicateColumn{hdr.Key}
+			}
+			res.AddTypedHeader(hdr.Key, hdr.Title, hdr.Type, hdr.Nullable)
+		}
+	}
+
+	all := append([]*Dataset{d}, others...)
+	for idx := range d.rows {
+		row := NewRow()
+		for _, ds := range all {
+			src := ds.rows[idx]
+			addColumns(row, src, sequence(src.Len()))
+			for _, tag := range src.Tags() {
+				row.AddTag(tag)
+			}
+		}
+		if err := res.Append(row); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// stackIndexes returns indexes of columns of other matching columns of d.
+func (d *Dataset) stackIndexes(other *Dataset) ([]int, error) {
+	if !d.HasHeaders() || !other.HasHeaders() {
+		return nil, nil
+	}
+	if other.HeaderCount() != d.HeaderCount() {
+		return nil, ErrInvalidRowWidth{
+			actual:   other.HeaderCount(),
+			expected: d.HeaderCount(),
+		}
+	}
+
+	idxs := make([]int, 0, d.HeaderCount())
+	for _, hdr := range d.Headers() {
+		idx, ok := other.getColumnIndex(hdr.Key)
+		if !ok {
+			return nil, ErrColumnNotFound{hdr.Key}
+		}
+		idxs = append(idxs, idx)
+	}
+	return idxs, nil
+}
+
+// appendRows appends copies of rows of src holding items on given indexes,
+// negative index adds null item. All items are copied when idxs is nil.
+func (d *Dataset) appendRows(src *Dataset, idxs []int) error {
+	for _, row := range src.rows {
+		rowIdxs := idxs
+		if rowIdxs == nil {
+			rowIdxs = sequence(row.Len())
+		}
+		if err := d.Append(row.project(rowIdxs)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
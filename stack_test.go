@@ -0,0 +1,173 @@
+package tabular
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StackTestSuite struct {
+	suite.Suite
+}
+
+func (s *StackTestSuite) items(d *Dataset) [][]string {
+	var items [][]string
+	for _, row := range d.Rows() {
+		items = append(items, row.Items())
+	}
+	return items
+}
+
+func (s *StackTestSuite) TestStack() {
+	d, err := newTestDataset()
+	s.NoError(err)
+
+	other := NewDataSet()
+	other.AddHeader("age", "Years")
+	other.AddHeader("name", "Name")
+	other.AddHeader("surname", "Surname")
+	r := NewRow("70", "Bill", "")
+	r.SetNull(2)
+	r.AddTag("new")
+	s.NoError(other.Append(r))
+
+	res, err := d.Stack(other)
+	s.NoError(err)
+	s.Equal([][]string{
+		{"Julia", "Roberts", "40"},
+		{"John", "Malkovich", "42"},
+		{"Bill", "", "70"},
+	}, s.items(res))
+	hdr, _ := res.GetHeader(2)
+	s.Equal("Age", hdr.Title)
+
+	last, _ := res.Get(2)
+	s.True(last.IsNull(1))
+	s.True(last.HasTag("new"))
+	s.Equal(2, d.Len())
+}
+
+func (s *StackTestSuite) TestStackWithoutHeaders() {
+	d := NewDataSet()
+	s.NoError(d.Append(NewRow("a", "b")))
+	other := NewDataSet()
+	s.NoError(other.Append(NewRow("c", "d")))
+
+	res, err := d.Stack(other, other)
+	s.NoError(err)
+	s.Equal([][]string{{"a", "b"}, {"c", "d"}, {"c", "d"}}, s.items(res))
+
+	wide := NewDataSet()
+	s.NoError(wide.Append(NewRow("c", "d", "e")))
+	_, err = d.Stack(wide)
+	s.Equal(ErrInvalidRowWidth{actual: 3, expected: 2}, err)
+}
+
+func (s *StackTestSuite) TestStackErrors() {
+	d, err := newTestDataset()
+	s.NoError(err)
+
+	other := NewDataSet()
+	other.AddHeader("name", "Name")
+	s.NoError(other.Append(NewRow("Bill")))
+	_, err = d.Stack(other)
+	s.Equal(ErrInvalidRowWidth{actual: 1, expected: 3}, err)
+
+	other.AddHeader("surname", "Surname")
+	other.AddHeader("years", "Years")
+	_, err = d.Stack(other)
+	s.Equal(ErrColumnNotFound{"age"}, err)
+}
+
+func (s *StackTestSuite) TestUnionByName() {
+	d, err := newTestDataset()
+	s.NoError(err)
+
+	other := NewDataSet()
+	other.AddHeader("name", "Name")
+	other.AddHeader("country", "Country")
+	s.NoError(other.Append(NewRow("Bill", "USA")))
+
+	typed := NewDataSet()
+	typed.AddTypedHeader("age", "Age", TypeInt, false)
+	s.NoError(typed.Append(NewRow("70")))
+
+	res, err := d.UnionByName(other, typed)
+	s.NoError(err)
+
+	var keys []string
+	for _, hdr := range res.Headers() {
+		keys = append(keys, hdr.Key)
+	}
+	s.Equal([]string{"name", "surname", "age", "country"}, keys)
+	s.Equal([][]string{
+		{"Julia", "Roberts", "40", ""},
+		{"John", "Malkovich", "42", ""},
+		{"Bill", "", "", "USA"},
+		{"", "", "70", ""},
+	}, s.items(res))
+
+	row, _ := res.Get(2)
+	s.True(row.IsNull(1))
+	s.True(row.IsNull(2))
+	s.False(row.IsNull(3))
+
+	_, err = d.UnionByName(NewDataSet())
+	s.Equal(ErrNoHeaders, err)
+}
+
+func (s *StackTestSuite) TestStackColumns() {
+	d, err := newTestDataset()
+	s.NoError(err)
+	r, _ := d.Get(0)
+	r.AddTag("a")
+
+	other := NewDataSet()
+	other.AddHeader("country", "Country")
+	r1 := NewRow("USA")
+	r1.AddTag("b")
+	r2 := NewRow("")
+	r2.SetNull(0)
+	s.NoError(other.Append(r1, r2))
+
+	res, err := d.StackColumns(other)
+	s.NoError(err)
+	s.Equal(4, res.HeaderCount())
+	s.Equal([][]string{
+		{"Julia", "Roberts", "40", "USA"},
+		{"John", "Malkovich", "42", ""},
+	}, s.items(res))
+
+	row, _ := res.Get(0)
+	s.True(row.HasAllTags("a", "b"))
+	row, _ = res.Get(1)
+	s.True(row.IsNull(3))
+	s.Equal(3, d.HeaderCount())
+
+	_, err = d.StackColumns(d)
+	s.Equal(ErrDuplicateColumn{"name"}, err)
+
+	s.NoError(other.Append(NewRow("CZ")))
+	_, err = d.StackColumns(other)
+	s.Equal(ErrInvalidColumnLength{actual: 3, expected: 2}, err)
+}
+
+func (s *StackTestSuite) TestStackColumnsWithoutHeaders() {
+	d := NewDataSet()
+	s.NoError(d.Append(NewRow("a"), NewRow("b")))
+	other := NewDataSet()
+	s.NoError(other.Append(NewRow("c", "d"), NewRow("e", "f")))
+
+	res, err := d.StackColumns(other)
+	s.NoError(err)
+	s.Equal([][]string{{"a", "c", "d"}, {"b", "e", "f"}}, s.items(res))
+
+	hdrs, err := newTestDataset()
+	s.NoError(err)
+	_, err = d.StackColumns(hdrs)
+	s.Equal(ErrNoHeaders, err)
+}
+
+func TestStackTestSuite(t *testing.T) {
+	suite.Run(t, new(StackTestSuite))
+}
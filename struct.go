@@ -0,0 +1,668 @@
+package tabular
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotStructSlice is returned when value passed to FromStructs is not a slice of structs.
+	ErrNotStructSlice = errors.New("value must be a slice of structs")
+)
+
+// ErrUnsupportedField is error returned when struct field type can not be converted.
+type ErrUnsupportedField struct {
+	field string
+	typ   reflect.Type
+}
+
+func (e ErrUnsupportedField) Error() string {
+	return fmt.Sprintf("Unsupported type %s of field %s.", e.typ, e.field)
+}
+
+// StructOpts represents options passed to FromStructs.
+type StructOpts struct {
+	// TimeLayout is used for time.Time fields without format, RFC 3339 is used when empty.
+	TimeLayout string
+
+	// Typed adds typed headers according to field types, pointer and
+	// omitempty fields are nullable. Fields with format and time fields
+	// with custom TimeLayout are strings, uint and uint64 fields are decimals.
+	Typed bool
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	stringerType      = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// structField represents struct field mapped to a column.
+type structField struct {
+	name      string
+	key       string
+	title     string
+	index     []int
+	typ       reflect.Type
+	omitEmpty bool
+	format    string
+
+	// tagged is true when key is set by the struct tag
+	tagged bool
+
+	// embedded is true for fields promoted from embedded struct pointer
+	embedded bool
+}
+
+// FromStructs creates new dataset from slice of structs or struct pointers.
+// Exported fields are mapped to columns using the tabular struct tag:
+//
+//	Name string `tabular:"name,title=First name,omitempty,format=%.2f"`
+//
+// Key defaults to the field name and title to the key. Fields tagged with
+// "-" are skipped, fields of embedded structs are promoted using the
+// shadowing rules of encoding/json. Nil pointers and zero values of omitempty
+// fields are null. Format is the layout of time.Time fields and fmt verb of
+// other fields, values implementing encoding.TextMarshaler or fmt.Stringer
+// are converted using them.
+func FromStructs(slice interface{}, opts *StructOpts) (*Dataset, error) {
+	v := reflect.ValueOf(slice)
+	if v.Kind() != reflect.Slice {
+		return nil, ErrNotStructSlice
+	}
+	elem := v.Type().Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return nil, ErrNotStructSlice
+	}
+
+	fields := structFields(elem)
+	d := NewDataSet()
+	for _, f := range fields {
+		if opts.Typed {
+			typ, nullable := f.columnType(opts)
+			d.AddTypedHeader(f.key, f.title, typ, nullable)
+		} else {
+			d.AddHeader(f.key, f.title)
+		}
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		sv := v.Index(i)
+		if sv.Kind() == reflect.Ptr {
+			if sv.IsNil() {
+				return nil, ErrNotStructSlice
+			}
+			sv = sv.Elem()
+		}
+
+		row := NewRow()
+		for _, f := range fields {
+			fv, ok := fieldByIndex(sv, f.index)
+			if !ok {
+				row.AddNull()
+				continue
+			}
+			val, null, err := f.toString(fv, opts)
+			if err != nil {
+				return nil, err
+			}
+			if null {
+				row.AddNull()
+			} else {
+				row.Add(val)
+			}
+		}
+		if err := d.Append(row); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// structFields returns fields of struct type t mapped to columns. Fields
+// with the same key follow shadowing rules of encoding/json, the shallowest
+// field wins, tagged field wins among fields at the same depth and
+// conflicting fields are dropped.
+func structFields(t reflect.Type) []structField {
+	all := embeddedFields(t)
+	byKey := make(map[string][]structField, len(all))
+	for _, f := range all {
+		byKey[f.key] = append(byKey[f.key], f)
+	}
+
+	var fields []structField
+	for _, f := range all {
+		if dominant, ok := dominantField(byKey[f.key]); ok && sameIndex(dominant.index, f.index) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// dominantField returns the field shadowing other fields with the same key,
+// false is returned when there is no such field.
+func dominantField(fields []structField) (structField, bool) {
+	if len(fields) == 1 {
+		return fields[0], true
+	}
+
+	depth := len(fields[0].index)
+	for _, f := range fields[1:] {
+		if len(f.index) < depth {
+			depth = len(f.index)
+		}
+	}
+
+	var (
+		shallow []structField
+		tagged  []structField
+	)
+	for _, f := range fields {
+		if len(f.index) != depth {
+			continue
+		}
+		shallow = append(shallow, f)
+		if f.tagged {
+			tagged = append(tagged, f)
+		}
+	}
+	if len(shallow) == 1 {
+		return shallow[0], true
+	}
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+	return structField{}, false
+}
+
+// sameIndex reports whether field index paths a and b are equal.
+func sameIndex(a []int, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// embeddedStruct represents struct type embedded at index path.
+type embeddedStruct struct {
+	typ      reflect.Type
+	index    []int
+	embedded bool
+}
+
+// embeddedFields returns fields of struct type t including all fields
+// promoted from embedded structs ordered by their index paths. Embedded
+// structs are visited level by level like in encoding/json, struct types
+// already visited are not descended into again, which stops recursion
+// of self-referential types.
+func embeddedFields(t reflect.Type) []structField {
+	var (
+		fields  []structField
+		next    = []embeddedStruct{{typ: t}}
+		visited = make(map[reflect.Type]bool)
+	)
+	for len(next) > 0 {
+		current := next
+		next = nil
+		for _, es := range current {
+			if visited[es.typ] {
+				continue
+			}
+			visited[es.typ] = true
+
+			for i := 0; i < es.typ.NumField(); i++ {
+				sf := es.typ.Field(i)
+				tag, tagged := sf.Tag.Lookup("tabular")
+				if tag == "-" {
+					continue
+				}
+
+				index := make([]int, len(es.index)+1)
+				copy(index, es.index)
+				index[len(es.index)] = i
+
+				ft := sf.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if sf.Anonymous && !tagged && ft.Kind() == reflect.Struct && ft != timeType {
+					next = append(next, embeddedStruct{
+						typ:      ft,
+						index:    index,
+						embedded: es.embedded || sf.Type.Kind() == reflect.Ptr,
+					})
+					continue
+				}
+				if sf.PkgPath != "" {
+					continue
+				}
+
+				f := parseStructTag(sf.Name, tag)
+				f.index = index
+				f.typ = sf.Type
+				f.embedded = es.embedded
+				fields = append(fields, f)
+			}
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return lessIndex(fields[i].index, fields[j].index)
+	})
+	return fields
+}
+
+// lessIndex reports whether field index path a precedes b in declaration order.
+func lessIndex(a []int, b []int) bool {
+	for i := range a {
+		if i >= len(b) {
+			return false
+		}
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// parseStructTag parses tabular struct tag of field name.
+func parseStructTag(name string, tag string) structField {
+	f := structField{name: name}
+	parts := strings.Split(tag, ",")
+	f.key = parts[0]
+	f.tagged = f.key != ""
+	for _, part := range parts[1:] {
+		switch {
+		case part == "omitempty":
+			f.omitEmpty = true
+		case strings.HasPrefix(part, "title="):
+			f.title = strings.TrimPrefix(part, "title=")
+		case strings.HasPrefix(part, "format="):
+			f.format = strings.TrimPrefix(part, "format=")
+		}
+	}
+	if f.key == "" {
+		f.key = name
+	}
+	if f.title == "" {
+		f.title = f.key
+	}
+	return f
+}
+
+// fieldByIndex returns nested field of v, false is returned when embedded
+// struct pointer on the path is nil.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, idx := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(idx)
+	}
+	return v, true
+}
+
+// columnType returns column type of the field, fields converted using
+// custom time layout or format are strings.
+func (f structField) columnType(opts *StructOpts) (ColumnType, bool) {
+	t := f.typ
+	nullable := f.omitEmpty || f.embedded
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		nullable = true
+	}
+
+	switch {
+	case t == timeType:
+		if f.format != "" || opts.TimeLayout != "" {
+			return TypeString, nullable
+		}
+		return TypeTime, nullable
+	case f.format != "", implements(t, textMarshalerType), implements(t, stringerType):
+		return TypeString, nullable
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return TypeString, nullable
+	case reflect.Bool:
+		return TypeBool, nullable
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return TypeInt, nullable
+	case reflect.Uint, reflect.Uint64:
+		// values above math.MaxInt64 do not fit int columns
+		return TypeDecimal, nullable
+	case reflect.Float32, reflect.Float64:
+		return TypeFloat, nullable
+	}
+	return TypeAny, nullable
+}
+
+// toString converts field value to string, true is returned for null values.
+func (f structField) toString(v reflect.Value, opts *StructOpts) (string, bool, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", true, nil
+		}
+		v = v.Elem()
+	}
+	if f.omitEmpty && v.IsZero() {
+		return "", true, nil
+	}
+
+	if v.Type() == timeType {
+		layout := f.format
+		if layout == "" {
+			layout = opts.TimeLayout
+		}
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		return v.Interface().(time.Time).Format(layout), false, nil
+	}
+
+	if f.format != "" {
+		return fmt.Sprintf(f.format, v.Interface()), false, nil
+	}
+
+	if m, ok := methodValue(v, textMarshalerType); ok {
+		text, err := m.(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return "", false, err
+		}
+		return string(text), false, nil
+	}
+	if m, ok := methodValue(v, stringerType); ok {
+		return m.(fmt.Stringer).String(), false, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), false, nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), false, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), false, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), false, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), false, nil
+	}
+
+	return "", false, ErrUnsupportedField{
+		field: f.name,
+		typ:   v.Type(),
+	}
+}
+
+// implements reports whether t or pointer to t implements iface.
+func implements(t reflect.Type, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PtrTo(t).Implements(iface)
+}
+
+// methodValue returns v or its address when it implements iface, pointer
+// receivers are used only for addressable values.
+func methodValue(v reflect.Value, iface reflect.Type) (interface{}, bool) {
+	if v.Type().Implements(iface) {
+		return v.Interface(), true
+	}
+	if v.CanAddr() && reflect.PtrTo(v.Type()).Implements(iface) {
+		return v.Addr().Interface(), true
+	}
+	return nil, false
+}
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// ErrInvalidCell is error returned when cell value can not be parsed into struct field.
+type ErrInvalidCell struct {
+	row int
+	key string
+	err error
+}
+
+func (e ErrInvalidCell) Error() string {
+	return fmt.Sprintf("Row %d, column %s: %v.", e.row, e.key, e.err)
+}
+
+// Row returns index of the row.
+func (e ErrInvalidCell) Row() int {
+	return e.row
+}
+
+// Key returns key of the column.
+func (e ErrInvalidCell) Key() string {
+	return e.key
+}
+
+// Unwrap returns the underlying error.
+func (e ErrInvalidCell) Unwrap() error {
+	return e.err
+}
+
+// ErrUnmarshal is error returned by Unmarshal listing all invalid cells.
+type ErrUnmarshal struct {
+	cells []ErrInvalidCell
+}
+
+func (e ErrUnmarshal) Error() string {
+	msgs := make([]string, 0, len(e.cells))
+	for _, cell := range e.cells {
+		msgs = append(msgs, cell.Error())
+	}
+	return fmt.Sprintf("Unmarshal failed for %d cells: %s", len(e.cells), strings.Join(msgs, " "))
+}
+
+// Cells returns errors of all invalid cells.
+func (e ErrUnmarshal) Cells() []ErrInvalidCell {
+	return e.cells
+}
+
+// Unmarshal stores rows of the dataset into slice of structs or struct
+// pointers v points to. Columns are mapped to fields by key using the same
+// tabular struct tag as FromStructs, format is used as time.Time layout.
+// Null values and empty values of non-string fields leave fields zero, so
+// do fields promoted from embedded pointers to unexported structs.
+// All rows are stored even when some cells are invalid, ErrUnmarshal
+// listing every invalid cell is returned in that case.
+func (d *Dataset) Unmarshal(v interface{}) error {
+	pv := reflect.ValueOf(v)
+	if pv.Kind() != reflect.Ptr || pv.IsNil() || pv.Elem().Kind() != reflect.Slice {
+		return ErrNotStructSlice
+	}
+	sv := pv.Elem()
+	elem := sv.Type().Elem()
+	isPtr := elem.Kind() == reflect.Ptr
+	if isPtr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return ErrNotStructSlice
+	}
+
+	type column struct {
+		idx   int
+		field structField
+	}
+	var cols []column
+	for _, f := range structFields(elem) {
+		idx, ok := d.getColumnIndex(f.key)
+		if !ok {
+			continue
+		}
+		if !canParse(f.typ) {
+			return ErrUnsupportedField{
+				field: f.name,
+				typ:   f.typ,
+			}
+		}
+		cols = append(cols, column{idx, f})
+	}
+
+	var cells []ErrInvalidCell
+	res := reflect.MakeSlice(sv.Type(), 0, d.Len())
+	for ridx, row := range d.rows {
+		item := reflect.New(elem)
+		for _, col := range cols {
+			if d.isNull(row, col.idx) {
+				continue
+			}
+			fv, ok := allocFieldByIndex(item.Elem(), col.field.index)
+			if !ok {
+				continue
+			}
+			if err := col.field.parse(fv, row.Get(col.idx)); err != nil {
+				cells = append(cells, ErrInvalidCell{
+					row: ridx,
+					key: col.field.key,
+					err: err,
+				})
+			}
+		}
+		if isPtr {
+			res = reflect.Append(res, item)
+		} else {
+			res = reflect.Append(res, item.Elem())
+		}
+	}
+	sv.Set(res)
+
+	if len(cells) > 0 {
+		return ErrUnmarshal{cells}
+	}
+	return nil
+}
+
+// allocFieldByIndex returns nested field of v, nil embedded struct pointers
+// on the path are allocated. False is returned when embedded pointer to
+// unexported struct can not be allocated.
+func allocFieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, idx := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(idx)
+	}
+	return v, true
+}
+
+// canParse checks if values of type t can be parsed from strings.
+func canParse(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType || reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// parse parses s into field value v.
+func (f structField) parse(v reflect.Value, s string) error {
+	if v.Kind() == reflect.Ptr {
+		if s == "" && v.Type().Elem().Kind() != reflect.String {
+			return nil
+		}
+		ptr := reflect.New(v.Type().Elem())
+		if err := f.parse(ptr.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(ptr)
+		return nil
+	}
+
+	if s == "" && v.Kind() != reflect.String {
+		return nil
+	}
+
+	switch {
+	case v.Type() == timeType:
+		t, err := f.parseTime(s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	case v.Type() == durationType:
+		dur, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(dur))
+		return nil
+	case v.Addr().Type().Implements(textUnmarshalerType):
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		fl, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(fl)
+	}
+	return nil
+}
+
+func (f structField) parseTime(s string) (time.Time, error) {
+	if f.format != "" {
+		return time.Parse(f.format, s)
+	}
+	return parseTime(s)
+}
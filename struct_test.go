@@ -0,0 +1,456 @@
+package tabular
+
+import (
+	"errors"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type testStatus int
+
+func (s testStatus) String() string {
+	if s == 1 {
+		return "active"
+	}
+	return "inactive"
+}
+
+type testAudit struct {
+	Created time.Time `tabular:"created,title=Created"`
+	secret  string
+}
+
+type testNote struct {
+	Text string `tabular:"note"`
+}
+
+type testUser struct {
+	testAudit
+	*testNote
+
+	Name     string     `tabular:"name,title=First name"`
+	Age      int        `tabular:"age,title=Age"`
+	Score    float64    `tabular:"score,format=%.2f"`
+	Nickname *string    `tabular:"nickname"`
+	Email    string     `tabular:",omitempty"`
+	Status   testStatus `tabular:"status"`
+	IP       net.IP     `tabular:"ip"`
+	Born     time.Time  `tabular:"born,format=2006-01-02"`
+	Admin    bool
+	Password string `tabular:"-"`
+	internal string
+}
+
+type testBadMarshaler struct{}
+
+func (testBadMarshaler) MarshalText() ([]byte, error) {
+	return nil, errors.New("bad")
+}
+
+type StructTestSuite struct {
+	suite.Suite
+}
+
+func (s *StructTestSuite) newUsers() []*testUser {
+	nick := "Jules"
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []*testUser{
+		{
+			testAudit: testAudit{Created: created, secret: "x"},
+			testNote:  &testNote{Text: "first"},
+			Name:      "Julia",
+			Age:       40,
+			Score:     9.5,
+			Nickname:  &nick,
+			Email:     "julia@example.com",
+			Status:    1,
+			IP:        net.IPv4(127, 0, 0, 1),
+			Born:      time.Date(1967, 10, 28, 0, 0, 0, 0, time.UTC),
+			Admin:     true,
+			Password:  "secret",
+		},
+		{
+			testAudit: testAudit{Created: created},
+			Name:      "John",
+			Age:       42,
+			IP:        net.IPv4(10, 0, 0, 1),
+		},
+	}
+}
+
+func (s *StructTestSuite) TestFromStructs() {
+	d, err := FromStructs(s.newUsers(), &StructOpts{})
+	s.NoError(err)
+
+	var keys, titles []string
+	for _, hdr := range d.Headers() {
+		keys = append(keys, hdr.Key)
+		titles = append(titles, hdr.Title)
+	}
+	s.Equal([]string{"created", "note", "name", "age", "score", "nickname", "Email", "status", "ip", "born", "Admin"}, keys)
+	s.Equal("First name", titles[2])
+	s.Equal("note", titles[1])
+
+	r1, _ := d.Get(0)
+	s.Equal([]string{"2020-01-02T03:04:05Z", "first", "Julia", "40", "9.50", "Jules", "julia@example.com", "active", "127.0.0.1", "1967-10-28", "true"}, r1.Items())
+
+	r2, _ := d.Get(1)
+	s.Equal([]string{"2020-01-02T03:04:05Z", "", "John", "42", "0.00", "", "", "inactive", "10.0.0.1", "0001-01-01", "false"}, r2.Items())
+	s.True(r2.IsNull(1))
+	s.True(r2.IsNull(5))
+	s.True(r2.IsNull(6))
+	s.False(r2.IsNull(4))
+}
+
+func (s *StructTestSuite) TestFromStructsValues() {
+	users := []testUser{*s.newUsers()[1]}
+	d, err := FromStructs(users, &StructOpts{
+		TimeLayout: "02.01.2006",
+	})
+	s.NoError(err)
+	s.Equal([]string{"02.01.2020"}, d.GetColValues("created"))
+}
+
+func (s *StructTestSuite) TestFromStructsTyped() {
+	d, err := FromStructs(s.newUsers(), &StructOpts{Typed: true})
+	s.NoError(err)
+
+	types := make(map[string]ColumnType)
+	nullable := make(map[string]bool)
+	for _, hdr := range d.Headers() {
+		types[hdr.Key] = hdr.Type
+		nullable[hdr.Key] = hdr.Nullable
+	}
+	s.Equal(TypeTime, types["created"])
+	s.Equal(TypeInt, types["age"])
+	s.Equal(TypeString, types["score"])
+	s.Equal(TypeBool, types["Admin"])
+	s.Equal(TypeString, types["status"])
+	s.Equal(TypeString, types["ip"])
+	s.Equal(TypeString, types["born"])
+	s.True(nullable["nickname"])
+	s.True(nullable["Email"])
+	s.False(nullable["age"])
+
+	out, err := newTestWrite(d, NewJSONWriter(&JSONOpts{}))
+	s.NoError(err)
+	s.Contains(out, `"age":42,"score":"0.00","nickname":null,"Email":null`)
+}
+
+func (s *StructTestSuite) TestFromStructsTypedFormats() {
+	type item struct {
+		ID    int       `tabular:"id,format=%x"`
+		Count int       `tabular:"count"`
+		Born  time.Time `tabular:"born"`
+	}
+	items := []item{{ID: 255, Count: 3, Born: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)}}
+
+	d, err := FromStructs(items, &StructOpts{Typed: true, TimeLayout: "02.01.2006"})
+	s.NoError(err)
+	s.Equal(TypeString, d.Headers()[0].Type)
+	s.Equal(TypeInt, d.Headers()[1].Type)
+	s.Equal(TypeString, d.Headers()[2].Type)
+
+	r, _ := d.Get(0)
+	s.Equal([]string{"ff", "3", "17.10.2026"}, r.Items())
+
+	d, err = FromStructs(items, &StructOpts{Typed: true})
+	s.NoError(err)
+	s.Equal(TypeTime, d.Headers()[2].Type)
+}
+
+type testBase struct {
+	ID   int
+	Name string `tabular:"name"`
+	Code string
+}
+
+type testOwner struct {
+	Code string `tabular:"Code"`
+}
+
+type testOther struct {
+	Code string
+	Note string
+}
+
+type testShadow struct {
+	testBase
+	testOwner
+	testOther
+
+	ID   int
+	Name string
+}
+
+func (s *StructTestSuite) TestFromStructsShadowing() {
+	items := []testShadow{{
+		testBase:  testBase{ID: 1, Name: "base", Code: "b"},
+		testOwner: testOwner{Code: "o"},
+		testOther: testOther{Code: "x", Note: "n"},
+		ID:        2,
+		Name:      "top",
+	}}
+
+	d, err := FromStructs(items, &StructOpts{})
+	s.NoError(err)
+
+	var keys []string
+	for _, hdr := range d.Headers() {
+		keys = append(keys, hdr.Key)
+	}
+	s.Equal([]string{"name", "Code", "Note", "ID", "Name"}, keys)
+
+	r, _ := d.Get(0)
+	s.Equal([]string{"base", "o", "n", "2", "top"}, r.Items())
+
+	var out []testShadow
+	s.NoError(d.Unmarshal(&out))
+	s.Equal(2, out[0].ID)
+	s.Equal(0, out[0].testBase.ID)
+	s.Equal("o", out[0].testOwner.Code)
+	s.Equal("", out[0].testBase.Code)
+}
+
+type testTree struct {
+	*testTree
+
+	Name string `tabular:"name"`
+}
+
+func (s *StructTestSuite) TestFromStructsSelfReferential() {
+	nodes := []testTree{{testTree: &testTree{Name: "parent"}, Name: "child"}}
+
+	d, err := FromStructs(nodes, &StructOpts{})
+	s.NoError(err)
+	s.Equal(1, d.HeaderCount())
+	s.Equal([]string{"child"}, d.GetColValues("name"))
+
+	var out []testTree
+	s.NoError(d.Unmarshal(&out))
+	s.Equal("child", out[0].Name)
+	s.Nil(out[0].testTree)
+}
+
+type testPoint struct {
+	X, Y int
+}
+
+func (p *testPoint) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(p.X) + ":" + strconv.Itoa(p.Y)), nil
+}
+
+func (p *testPoint) UnmarshalText(text []byte) error {
+	parts := strings.SplitN(string(text), ":", 2)
+	if len(parts) != 2 {
+		return errors.New("invalid point")
+	}
+	p.X, _ = strconv.Atoi(parts[0])
+	p.Y, _ = strconv.Atoi(parts[1])
+	return nil
+}
+
+type testLevel int
+
+func (l *testLevel) String() string {
+	return "level " + strconv.Itoa(int(*l))
+}
+
+func (s *StructTestSuite) TestFromStructsTypedUnsigned() {
+	type counter struct {
+		Small uint32 `tabular:"small"`
+		Big   uint64 `tabular:"big"`
+		Size  uint   `tabular:"size"`
+	}
+	items := []counter{{Small: 1, Big: math.MaxUint64, Size: 2}}
+
+	d, err := FromStructs(items, &StructOpts{Typed: true})
+	s.NoError(err)
+	s.Equal(TypeInt, d.Headers()[0].Type)
+	s.Equal(TypeDecimal, d.Headers()[1].Type)
+	s.Equal(TypeDecimal, d.Headers()[2].Type)
+	s.Equal([]string{"18446744073709551615"}, d.GetColValues("big"))
+
+	var out []counter
+	s.NoError(d.Unmarshal(&out))
+	s.Equal(items, out)
+}
+
+func (s *StructTestSuite) TestFromStructsPointerReceivers() {
+	type shape struct {
+		Point testPoint  `tabular:"point"`
+		Ref   *testPoint `tabular:"ref"`
+		Level testLevel  `tabular:"level"`
+	}
+	shapes := []shape{{Point: testPoint{1, 2}, Ref: &testPoint{3, 4}, Level: 5}}
+
+	d, err := FromStructs(shapes, &StructOpts{Typed: true})
+	s.NoError(err)
+	s.Equal(TypeString, d.Headers()[0].Type)
+	s.Equal(TypeString, d.Headers()[2].Type)
+
+	r, _ := d.Get(0)
+	s.Equal([]string{"1:2", "3:4", "level 5"}, r.Items())
+
+	var out []struct {
+		Point testPoint  `tabular:"point"`
+		Ref   *testPoint `tabular:"ref"`
+	}
+	s.NoError(d.Unmarshal(&out))
+	s.Equal(testPoint{1, 2}, out[0].Point)
+	s.Equal(&testPoint{3, 4}, out[0].Ref)
+}
+
+func (s *StructTestSuite) TestFromStructsErrors() {
+	_, err := FromStructs(testUser{}, &StructOpts{})
+	s.Equal(ErrNotStructSlice, err)
+
+	_, err = FromStructs([]string{"a"}, &StructOpts{})
+	s.Equal(ErrNotStructSlice, err)
+
+	_, err = FromStructs([]*testUser{nil}, &StructOpts{})
+	s.Equal(ErrNotStructSlice, err)
+
+	type tags struct {
+		Tags []string
+	}
+	_, err = FromStructs([]tags{{}}, &StructOpts{})
+	s.Equal("Unsupported type []string of field Tags.", err.Error())
+
+	type bad struct {
+		Value testBadMarshaler
+	}
+	_, err = FromStructs([]bad{{}}, &StructOpts{})
+	s.EqualError(err, "bad")
+}
+
+type testRecord struct {
+	*testNote
+
+	Name    string        `tabular:"name"`
+	Age     int8          `tabular:"age"`
+	Score   *float64      `tabular:"score"`
+	Active  bool          `tabular:"active"`
+	Timeout time.Duration `tabular:"timeout"`
+	Born    time.Time     `tabular:"born,format=02.01.2006"`
+	Created time.Time     `tabular:"created"`
+	IP      net.IP        `tabular:"ip"`
+	Count   uint          `tabular:"count"`
+	Skipped string        `tabular:"-"`
+	Missing string        `tabular:"missing"`
+}
+
+func (s *StructTestSuite) newRecords() *Dataset {
+	d := NewDataSet()
+	for _, key := range []string{"name", "age", "score", "active", "timeout", "born", "created", "ip", "count", "note", "Skipped"} {
+		d.AddHeader(key, key)
+	}
+	s.Require().NoError(d.Append(
+		NewRow("Julia", "40", "9.5", "true", "1m30s", "28.10.1967", "2020-01-02 03:04:05", "127.0.0.1", "7", "first", "x"),
+	))
+	r := NewRow("John", "", "", "false", "", "", "2020-01-02", "", "", "", "")
+	r.SetNull(0)
+	r.SetNull(9)
+	s.Require().NoError(d.Append(r))
+	return d
+}
+
+func (s *StructTestSuite) TestUnmarshal() {
+	d := s.newRecords()
+
+	var records []testRecord
+	s.NoError(d.Unmarshal(&records))
+	s.Len(records, 2)
+
+	r := records[0]
+	s.Equal("Julia", r.Name)
+	s.Equal(int8(40), r.Age)
+	s.Equal(9.5, *r.Score)
+	s.True(r.Active)
+	s.Equal(90*time.Second, r.Timeout)
+	s.Equal(time.Date(1967, 10, 28, 0, 0, 0, 0, time.UTC), r.Born)
+	s.Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), r.Created)
+	s.Equal("127.0.0.1", r.IP.String())
+	s.Equal(uint(7), r.Count)
+	s.Nil(r.testNote)
+	s.Equal("", r.Skipped)
+
+	r = records[1]
+	s.Equal("", r.Name)
+	s.Nil(r.Score)
+	s.Nil(r.testNote)
+	s.Nil(r.IP)
+	s.True(r.Born.IsZero())
+
+	type Extra struct {
+		Note string `tabular:"note"`
+	}
+	var ptrs []*struct {
+		*Extra
+		Name string `tabular:"name"`
+	}
+	s.NoError(d.Unmarshal(&ptrs))
+	s.Len(ptrs, 2)
+	s.Equal("first", ptrs[0].Note)
+	s.Equal("Julia", ptrs[0].Name)
+	s.Nil(ptrs[1].Extra)
+}
+
+func (s *StructTestSuite) TestUnmarshalRoundTrip() {
+	type user struct {
+		Name  string  `tabular:"name,title=First name"`
+		Email *string `tabular:"email"`
+		Age   int     `tabular:"age"`
+	}
+	email := "julia@example.com"
+	users := []user{{"Julia", &email, 40}, {"John", nil, 42}}
+
+	d, err := FromStructs(users, &StructOpts{})
+	s.NoError(err)
+
+	var loaded []user
+	s.NoError(d.Unmarshal(&loaded))
+	s.Equal(users, loaded)
+}
+
+func (s *StructTestSuite) TestUnmarshalErrors() {
+	d := s.newRecords()
+	s.NoError(d.Append(NewRow("Bill", "300", "x", "yes", "10", "1967-10-28", "now", "::1", "-1", "", "")))
+
+	var records []testRecord
+	err := d.Unmarshal(&records)
+	s.Error(err)
+	s.Len(records, 3)
+	s.Equal("Bill", records[2].Name)
+	s.Equal("::1", records[2].IP.String())
+
+	uerr, ok := err.(ErrUnmarshal)
+	s.True(ok)
+
+	var keys []string
+	for _, cell := range uerr.Cells() {
+		s.Equal(2, cell.Row())
+		keys = append(keys, cell.Key())
+	}
+	s.Equal([]string{"age", "score", "active", "timeout", "born", "created", "count"}, keys)
+	s.True(errors.Is(uerr.Cells()[0], strconv.ErrRange))
+	s.Contains(err.Error(), `Unmarshal failed for 7 cells: Row 2, column age: strconv.ParseInt: parsing "300": value out of range.`)
+
+	s.Equal(ErrNotStructSlice, d.Unmarshal(records))
+	s.Equal(ErrNotStructSlice, d.Unmarshal(&[]string{}))
+
+	var bad []struct {
+		Name []string `tabular:"name"`
+	}
+	s.Equal("Unsupported type []string of field Name.", d.Unmarshal(&bad).Error())
+}
+
+func TestStructTestSuite(t *testing.T) {
+	suite.Run(t, new(StructTestSuite))
+}
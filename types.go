@@ -0,0 +1,163 @@
+package tabular
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ColumnType represents type of column values.
+type ColumnType int
+
+const (
+	// TypeAny is used for untyped columns, values are not validated.
+	TypeAny ColumnType = iota
+
+	// TypeString holds text values.
+	TypeString
+
+	// TypeInt holds 64-bit integer values.
+	TypeInt
+
+	// TypeFloat holds 64-bit floating point values.
+	TypeFloat
+
+	// TypeBool holds boolean values as accepted by strconv.ParseBool.
+	TypeBool
+
+	// TypeTime holds time values in RFC 3339, 2006-01-02 15:04:05 or 2006-01-02 layout.
+	TypeTime
+
+	// TypeDecimal holds arbitrary precision decimal numbers.
+	TypeDecimal
+)
+
+var columnTypeNames = map[ColumnType]string{
+	TypeAny:     "any",
+	TypeString:  "string",
+	TypeInt:     "int",
+	TypeFloat:   "float",
+	TypeBool:    "bool",
+	TypeTime:    "time",
+	TypeDecimal: "decimal",
+}
+
+// String returns name of the column type.
+func (t ColumnType) String() string {
+	if name, ok := columnTypeNames[t]; ok {
+		return name
+	}
+	return "ColumnType(" + strconv.Itoa(int(t)) + ")"
+}
+
+// IsNumeric returns true for int, float and decimal types.
+func (t ColumnType) IsNumeric() bool {
+	return t == TypeInt || t == TypeFloat || t == TypeDecimal
+}
+
+// ErrInvalidValue is error returned when adding row with value not matching the column type.
+type ErrInvalidValue struct {
+	key   string
+	value string
+	typ   ColumnType
+}
+
+func (e ErrInvalidValue) Error() string {
+	return fmt.Sprintf("Invalid %s value %q in column %s.", e.typ, e.value, e.key)
+}
+
+// ErrNullValue is error returned when adding row with null value into typed column which is not nullable.
+type ErrNullValue struct {
+	key string
+}
+
+func (e ErrNullValue) Error() string {
+	return fmt.Sprintf("Null value in column %s which is not nullable.", e.key)
+}
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+var decimalRegexp = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// parseValue parses s into native value of given column type.
+func parseValue(typ ColumnType, s string) (interface{}, error) {
+	switch typ {
+	case TypeInt:
+		return strconv.ParseInt(s, 10, 64)
+	case TypeFloat:
+		return strconv.ParseFloat(s, 64)
+	case TypeBool:
+		return strconv.ParseBool(s)
+	case TypeTime:
+		return parseTime(s)
+	case TypeDecimal:
+		return parseDecimal(s)
+	default:
+		return s, nil
+	}
+}
+
+func parseTime(s string) (time.Time, error) {
+	var err error
+	for _, layout := range timeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// parseDecimal validates decimal and returns it in canonical form without
+// leading plus sign and superfluous leading zeros.
+func parseDecimal(s string) (string, error) {
+	if !decimalRegexp.MatchString(s) {
+		return "", fmt.Errorf("invalid decimal %q", s)
+	}
+
+	var sign string
+	switch s[0] {
+	case '-':
+		sign, s = "-", s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	s = strings.TrimLeft(s, "0")
+	if s == "" || s[0] == '.' {
+		s = "0" + s
+	}
+	return sign + s, nil
+}
+
+// formatValue returns canonical text representation of typed value s. Values
+// which can not be parsed are returned unchanged.
+func formatValue(typ ColumnType, s string) string {
+	v, err := parseValue(typ, s)
+	if err != nil {
+		return s
+	}
+
+	switch val := v.(type) {
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case float64:
+		if isNumeric(s) {
+			return s
+		}
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	case string:
+		return val
+	default:
+		// times keep their original layout
+		return s
+	}
+}
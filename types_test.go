@@ -0,0 +1,80 @@
+package tabular
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TypesTestSuite struct {
+	suite.Suite
+}
+
+func (s *TypesTestSuite) TestParseValue() {
+	v, err := parseValue(TypeInt, "42")
+	s.NoError(err)
+	s.Equal(int64(42), v)
+
+	v, err = parseValue(TypeFloat, "1.5")
+	s.NoError(err)
+	s.Equal(1.5, v)
+
+	v, err = parseValue(TypeBool, "TRUE")
+	s.NoError(err)
+	s.Equal(true, v)
+
+	v, err = parseValue(TypeTime, "2020-01-02")
+	s.NoError(err)
+	s.Equal(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), v)
+
+	v, err = parseValue(TypeTime, "2020-01-02T10:20:30+02:00")
+	s.NoError(err)
+	s.True(time.Date(2020, 1, 2, 8, 20, 30, 0, time.UTC).Equal(v.(time.Time)))
+
+	v, err = parseValue(TypeString, "x")
+	s.NoError(err)
+	s.Equal("x", v)
+
+	_, err = parseValue(TypeInt, "4.2")
+	s.Error(err)
+	_, err = parseValue(TypeTime, "yesterday")
+	s.Error(err)
+	_, err = parseValue(TypeDecimal, "1e5")
+	s.Error(err)
+}
+
+func (s *TypesTestSuite) TestParseDecimal() {
+	cases := map[string]string{
+		"0":       "0",
+		"007":     "7",
+		"+1.50":   "1.50",
+		"-0012.3": "-12.3",
+		"000.5":   "0.5",
+	}
+	for in, expected := range cases {
+		out, err := parseDecimal(in)
+		s.NoError(err)
+		s.Equal(expected, out, in)
+	}
+}
+
+func (s *TypesTestSuite) TestFormatValue() {
+	s.Equal("7", formatValue(TypeInt, "+007"))
+	s.Equal("1.50", formatValue(TypeFloat, "1.50"))
+	s.Equal("0.5", formatValue(TypeFloat, ".5"))
+	s.Equal("false", formatValue(TypeBool, "0"))
+	s.Equal("2020-01-02", formatValue(TypeTime, "2020-01-02"))
+	s.Equal("abc", formatValue(TypeInt, "abc"))
+}
+
+func (s *TypesTestSuite) TestString() {
+	s.Equal("decimal", TypeDecimal.String())
+	s.Equal("ColumnType(42)", ColumnType(42).String())
+	s.True(TypeFloat.IsNumeric())
+	s.False(TypeBool.IsNumeric())
+}
+
+func TestTypesTestSuite(t *testing.T) {
+	suite.Run(t, new(TypesTestSuite))
+}
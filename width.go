@@ -0,0 +1,81 @@
+package tabular
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+const (
+	zeroWidthJoiner    = '\u200d'
+	variationSelector  = '\ufe0f'
+	emojiModifierFirst = '\U0001f3fb'
+	emojiModifierLast  = '\U0001f3ff'
+)
+
+// displayWidth returns the number of terminal cells needed to display s.
+func displayWidth(s string) int {
+	total := 0
+	for len(s) > 0 {
+		size, w := nextCluster(s)
+		total += w
+		s = s[size:]
+	}
+	return total
+}
+
+// nextCluster returns byte size and display width of the first character
+// cluster in s. A cluster is a base rune followed by combining marks,
+// variation selectors, emoji modifiers and runes joined using zero width joiner.
+func nextCluster(s string) (int, int) {
+	r, size := utf8.DecodeRuneInString(s)
+	w := runeWidth(r)
+	i := size
+
+	for i < len(s) {
+		r, size = utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == zeroWidthJoiner:
+			i += size
+			if i < len(s) {
+				_, size = utf8.DecodeRuneInString(s[i:])
+				i += size
+			}
+		case r == variationSelector:
+			// emoji presentation turns narrow symbols into wide ones
+			if w == 1 {
+				w = 2
+			}
+			i += size
+		case r >= emojiModifierFirst && r <= emojiModifierLast, isZeroWidth(r):
+			i += size
+		default:
+			return i, w
+		}
+	}
+	return i, w
+}
+
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20 || (r >= 0x7f && r < 0xa0):
+		return 0
+	case isZeroWidth(r):
+		return 0
+	}
+
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	}
+	return 1
+}
+
+func isZeroWidth(r rune) bool {
+	// Hangul medial vowels and final consonants combine with the initial consonant
+	if r >= 0x1160 && r <= 0x11ff {
+		return true
+	}
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf)
+}
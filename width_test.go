@@ -0,0 +1,41 @@
+package tabular
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WidthTestSuite struct {
+	suite.Suite
+}
+
+func (s *WidthTestSuite) TestDisplayWidth() {
+	cases := []struct {
+		in    string
+		width int
+	}{
+		{"", 0},
+		{"John", 4},
+		{"Čapek", 5},
+		{"José", 4},
+		{"東京", 4},
+		{"ｈｉ", 4},
+		{"한국어", 6},
+		{"각", 2},
+		{"👍", 2},
+		{"\U0001f44d\U0001f3fd", 2},
+		{"\u2764\ufe0f", 2},
+		{"\U0001f469\u200d\U0001f469\u200d\U0001f467", 2},
+		{"a\u200bb", 2},
+		{"tab\there", 7},
+	}
+
+	for _, c := range cases {
+		s.Equal(c.width, displayWidth(c.in), c.in)
+	}
+}
+
+func TestWidthTestSuite(t *testing.T) {
+	suite.Run(t, new(WidthTestSuite))
+}
@@ -15,6 +15,23 @@ func (e ErrInvalidHeaderIndex) Error() string {
 	return fmt.Sprintf("Invalid header index %d.", e.idx)
 }
 
+// Alignment represents horizontal alignment of a column.
+type Alignment int
+
+const (
+	// AlignDefault leaves the alignment up to the writer.
+	AlignDefault Alignment = iota
+
+	// AlignLeft aligns column to the left.
+	AlignLeft
+
+	// AlignCenter centers the column.
+	AlignCenter
+
+	// AlignRight aligns column to the right.
+	AlignRight
+)
+
 // Writer represents a dataset writer.
 type Writer interface {
 	// Name returns name of the writer.
@@ -27,10 +44,48 @@ type Writer interface {
 	Write(d *Dataset, w io.Writer) error
 }
 
+// BookWriter represents a writer of formats holding multiple datasets.
+type BookWriter interface {
+	// Name returns name of the writer.
+	Name() string
+
+	// NeedsHeaders returns true if headers are required.
+	NeedsHeaders() bool
+
+	// WriteBook writes databook to writer.
+	WriteBook(b *Databook, w io.Writer) error
+}
+
+// Reader represents a dataset reader.
+type Reader interface {
+	// Name returns name of the reader.
+	Name() string
+
+	// Read reads dataset from reader.
+	Read(r io.Reader) (*Dataset, error)
+}
+
 func padString(s string, total int) string {
-	length := len(s)
+	length := displayWidth(s)
 	if length >= total {
 		return s
 	}
 	return s + strings.Repeat(" ", total-length)
 }
+
+func alignString(s string, total int, align Alignment) string {
+	length := displayWidth(s)
+	if length >= total {
+		return s
+	}
+
+	switch align {
+	case AlignRight:
+		return strings.Repeat(" ", total-length) + s
+	case AlignCenter:
+		left := (total - length) / 2
+		return strings.Repeat(" ", left) + s + strings.Repeat(" ", total-length-left)
+	default:
+		return padString(s, total)
+	}
+}
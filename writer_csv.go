@@ -9,6 +9,9 @@ import (
 type CSVOpts struct {
 	Comma   rune
 	UseCRLF bool
+
+	// NullToken is written in place of null values.
+	NullToken string
 }
 
 // NewCSVWriter creates a new CSV dataset writer.
@@ -48,9 +51,16 @@ func (wc *CSVWriter) Write(d *Dataset, w io.Writer) error {
 		}
 	}
 
+	items := make([]string, 0, d.cols)
 	for _, row := range d.rows {
-		err := cw.Write(row.Items())
-		if err != nil {
+		items = items[:0]
+		for idx, item := range row.Items() {
+			if d.isNull(row, idx) {
+				item = wc.opts.NullToken
+			}
+			items = append(items, item)
+		}
+		if err := cw.Write(items); err != nil {
 			return err
 		}
 	}
@@ -59,6 +59,24 @@ func (s *CSVWriterTestSuite) TestWriteCRLF() {
 	s.Equal(expected, out)
 }
 
+func (s *CSVWriterTestSuite) TestWriteNull() {
+	opts := &CSVOpts{
+		Comma:     ',',
+		NullToken: "NULL",
+	}
+	w := NewCSVWriter(opts)
+	d, err := newTestNullDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `First name,Last name,Age
+Julia,Roberts,NULL
+John,NULL,42
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
 func TestCSVWriterTestSuite(t *testing.T) {
 	suite.Run(t, new(CSVWriterTestSuite))
 }
@@ -16,6 +16,9 @@ type HTMLOpts struct {
 	RowClass   string
 	HeadClass  string
 	DataClass  string
+
+	// NullPlaceholder is written in place of null values.
+	NullPlaceholder string
 }
 
 // NewHTMLWriter creates a new HTML dataset writer.
@@ -45,6 +48,12 @@ func (wh *HTMLWriter) Write(d *Dataset, w io.Writer) error {
 	return tw.write()
 }
 
+// WriteBook writes databook to writer, each dataset is written as a table in a separate section.
+func (wh *HTMLWriter) WriteBook(b *Databook, w io.Writer) error {
+	tw := newHTMLTableWriter(nil, w, wh.opts)
+	return tw.writeBook(b)
+}
+
 func newHTMLTableWriter(d *Dataset, w io.Writer, opts *HTMLOpts) *htmlTableWriter {
 	return &htmlTableWriter{
 		d:    d,
@@ -61,11 +70,27 @@ type htmlTableWriter struct {
 }
 
 func (h *htmlTableWriter) write() error {
+	h.writeTable(0, h.opts.Caption)
+	return h.flush()
+}
+
+func (h *htmlTableWriter) writeBook(b *Databook) error {
 	level := 0
+	for _, sheet := range b.Sheets() {
+		h.d = sheet.Dataset
+		h.writeStartElem("section", level, "", true)
+		h.writeInlineElem("h2", sheet.Name, "", level+1)
+		h.writeTable(level+1, h.opts.Caption)
+		h.writeEndElem("section", level, true)
+	}
+	return h.flush()
+}
+
+func (h *htmlTableWriter) writeTable(level int, caption string) {
 	h.writeStartElem("table", level, h.opts.TableClass, true)
 
-	if h.opts.Caption != "" {
-		h.writeInlineElem("caption", h.opts.Caption, "", level+1)
+	if caption != "" {
+		h.writeInlineElem("caption", caption, "", level+1)
 	}
 
 	if h.d.HasHeaders() {
@@ -78,9 +103,7 @@ func (h *htmlTableWriter) write() error {
 	h.writeRows(level + 2)
 	h.writeEndElem("tbody", level+1, true)
 
-	h.writeEndElem("table", level+0, true)
-
-	return h.flush()
+	h.writeEndElem("table", level, true)
 }
 
 func (h *htmlTableWriter) writeHeaders(level int) {
@@ -103,7 +126,10 @@ func (h *htmlTableWriter) writeRows(level int) {
 
 func (h *htmlTableWriter) writeRow(row *Row, level int) {
 	h.writeStartElem("tr", level, h.opts.RowClass, true)
-	for _, item := range row.Items() {
+	for idx, item := range row.Items() {
+		if h.d.isNull(row, idx) {
+			item = h.opts.NullPlaceholder
+		}
 		h.writeRowItem(item, level+1)
 	}
 	h.writeEndElem("tr", level, true)
@@ -214,6 +214,74 @@ func (s *HTMLWriterTestSuite) TestWriteCaptionEscape() {
 	s.Equal(expected, out)
 }
 
+func (s *HTMLWriterTestSuite) TestWriteBook() {
+	opts := &HTMLOpts{
+		Indent: 2,
+	}
+	w := NewHTMLWriter(opts)
+	b, err := newTestDatabook()
+	s.Nil(err)
+	out, err := newTestWriteBook(b, w)
+	expected :=
+		`<section>
+  <h2>Actors</h2>
+  <table>
+    <thead>
+      <tr>
+        <th>First name</th>
+        <th>Last name</th>
+        <th>Age</th>
+      </tr>
+    </thead>
+    <tbody>
+      <tr>
+        <td>Julia</td>
+        <td>Roberts</td>
+        <td>40</td>
+      </tr>
+      <tr>
+        <td>John</td>
+        <td>Malkovich</td>
+        <td>42</td>
+      </tr>
+    </tbody>
+  </table>
+</section>
+<section>
+  <h2>Totals</h2>
+  <table>
+    <thead>
+      <tr>
+        <th>Count</th>
+      </tr>
+    </thead>
+    <tbody>
+      <tr>
+        <td>2</td>
+      </tr>
+    </tbody>
+  </table>
+</section>
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *HTMLWriterTestSuite) TestWriteNull() {
+	opts := &HTMLOpts{
+		NullPlaceholder: "-",
+	}
+	w := NewHTMLWriter(opts)
+	d, err := newTestNullDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `<table><thead><tr><th>First name</th><th>Last name</th><th>Age</th></tr></thead><tbody><tr><td>Julia</td><td>Roberts</td><td>-</td></tr><tr><td>John</td><td>-</td><td>42</td></tr></tbody></table>`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
 func TestHTMLWriterTestSuite(t *testing.T) {
 	suite.Run(t, new(HTMLWriterTestSuite))
 }
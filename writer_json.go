@@ -39,6 +39,12 @@ func (wj *JSONWriter) Write(d *Dataset, w io.Writer) error {
 	return tw.write()
 }
 
+// WriteBook writes databook to writer as an object keyed by sheet names.
+func (wj *JSONWriter) WriteBook(b *Databook, w io.Writer) error {
+	tw := newJSONTableWriter(nil, w, wj.opts)
+	return tw.writeBook(b)
+}
+
 func newJSONTableWriter(d *Dataset, w io.Writer, opts *JSONOpts) *jsonTableWriter {
 	return &jsonTableWriter{
 		d:    d,
@@ -55,8 +61,34 @@ type jsonTableWriter struct {
 }
 
 func (j *jsonTableWriter) write() error {
+	j.writeTable(0)
+	return j.flush()
+}
+
+func (j *jsonTableWriter) writeBook(b *Databook) error {
 	level := 0
-	j.writeIndent("[", level)
+	j.writeIndent("{", level)
+
+	for sidx, sheet := range b.Sheets() {
+		j.d = sheet.Dataset
+		j.writeInlineIndent("", level+1)
+		j.writeEscaped(sheet.Name)
+		j.writeSeparator()
+		j.writeTable(level + 1)
+
+		if sidx+1 != b.Len() {
+			j.writeString(",")
+		}
+		j.writeOnIndent("\n")
+	}
+
+	j.writeString("}")
+	return j.flush()
+}
+
+func (j *jsonTableWriter) writeTable(level int) {
+	j.writeString("[")
+	j.writeOnIndent("\n")
 
 	for ridx, row := range j.d.Rows() {
 		j.writeIndent("{", level+1)
@@ -64,14 +96,8 @@ func (j *jsonTableWriter) write() error {
 		for hidx, hdr := range j.d.Headers() {
 			j.writeInlineIndent("", level+2)
 			j.writeEscaped(hdr.Key)
-
-			if j.opts.Indent > 0 {
-				j.writeString(": ")
-			} else {
-				j.writeString(":")
-			}
-
-			j.writeEscaped(row.Get(hidx))
+			j.writeSeparator()
+			j.writeValue(hdr, row, hidx)
 
 			if hidx+1 != j.d.HeaderCount() {
 				j.writeString(",")
@@ -89,9 +115,31 @@ func (j *jsonTableWriter) write() error {
 	}
 
 	j.writeOnIndent("\n")
-	j.writeString("]")
+	j.writeInlineIndent("]", level)
+}
+
+func (j *jsonTableWriter) writeValue(hdr *Header, row *Row, idx int) {
+	val := row.Get(idx)
+	switch {
+	case hdr.isNull(row, idx):
+		j.writeString("null")
+	case hdr.Type.IsNumeric(), hdr.Type == TypeBool:
+		if formatted := formatValue(hdr.Type, val); isNumeric(formatted) || formatted == "true" || formatted == "false" {
+			j.writeString(formatted)
+			return
+		}
+		j.writeEscaped(val)
+	default:
+		j.writeEscaped(val)
+	}
+}
 
-	return j.flush()
+func (j *jsonTableWriter) writeSeparator() {
+	if j.opts.Indent > 0 {
+		j.writeString(": ")
+	} else {
+		j.writeString(":")
+	}
 }
 
 func (j *jsonTableWriter) flush() error {
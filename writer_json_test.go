@@ -74,6 +74,75 @@ func (s *JSONWriterTestSuite) TestWriteNoIndent() {
 	s.Equal(expected, out)
 }
 
+func (s *JSONWriterTestSuite) TestWriteBook() {
+	opts := &JSONOpts{
+		Indent: 2,
+	}
+	w := NewJSONWriter(opts)
+	b, err := newTestDatabook()
+	s.Nil(err)
+	out, err := newTestWriteBook(b, w)
+	expected := `{
+  "Actors": [
+    {
+      "name": "Julia",
+      "surname": "Roberts",
+      "age": "40"
+    },
+    {
+      "name": "John",
+      "surname": "Malkovich",
+      "age": "42"
+    }
+  ],
+  "Totals": [
+    {
+      "count": "2"
+    }
+  ]
+}`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *JSONWriterTestSuite) TestWriteBookNoIndent() {
+	opts := &JSONOpts{}
+	w := NewJSONWriter(opts)
+	b, err := newTestDatabook()
+	s.Nil(err)
+	out, err := newTestWriteBook(b, w)
+	expected := `{"Actors":[{"name":"Julia","surname":"Roberts","age":"40"},{"name":"John","surname":"Malkovich","age":"42"}],"Totals":[{"count":"2"}]}`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *JSONWriterTestSuite) TestWriteTyped() {
+	opts := &JSONOpts{}
+	w := NewJSONWriter(opts)
+	d, err := newTestTypedDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `[{"name":"Julia","age":40,"height":1.75,"active":true,"born":"1967-10-28","salary":1200.50},` +
+		`{"name":"007","age":42,"height":null,"active":false,"born":null,"salary":null}]`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *JSONWriterTestSuite) TestWriteNull() {
+	opts := &JSONOpts{}
+	w := NewJSONWriter(opts)
+	d, err := newTestNullDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `[{"name":"Julia","surname":"Roberts","age":null},{"name":"John","surname":null,"age":"42"}]`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
 func TestJSONWriterTestSuite(t *testing.T) {
 	suite.Run(t, new(JSONWriterTestSuite))
 }
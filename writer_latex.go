@@ -11,6 +11,9 @@ type LatexOpts struct {
 	Caption  string
 	Center   bool
 	TabularX bool
+
+	// NullPlaceholder is written in place of null values.
+	NullPlaceholder string
 }
 
 // NewLatexWriter creates a new LaTeX dataset writer.
@@ -40,6 +43,12 @@ func (wl *LatexWriter) Write(d *Dataset, w io.Writer) error {
 	return tw.write()
 }
 
+// WriteBook writes databook to writer, each dataset is written as a separate table captioned by sheet name.
+func (wl *LatexWriter) WriteBook(b *Databook, w io.Writer) error {
+	tw := newLatexTableWriter(nil, w, wl.opts)
+	return tw.writeBook(b)
+}
+
 var latexReplacements = []string{
 	"&", "\\&",
 	"%", "\\%",
@@ -71,6 +80,22 @@ type latexTableWriter struct {
 }
 
 func (l *latexTableWriter) write() error {
+	l.writeTable(l.opts.Caption)
+	return l.flush()
+}
+
+func (l *latexTableWriter) writeBook(b *Databook) error {
+	for idx, sheet := range b.Sheets() {
+		if idx > 0 {
+			l.writeString("\n")
+		}
+		l.d = sheet.Dataset
+		l.writeTable(sheet.Name)
+	}
+	return l.flush()
+}
+
+func (l *latexTableWriter) writeTable(caption string) {
 	l.writeString("\\begin{table}[h]\n")
 	l.writeHead()
 
@@ -85,12 +110,11 @@ func (l *latexTableWriter) write() error {
 		l.writeString("\\end{tabular}\n")
 	}
 
-	if l.opts.Caption != "" {
-		l.writeElem("caption", l.opts.Caption)
+	if caption != "" {
+		l.writeElem("caption", caption)
 	}
 
 	l.writeString("\\end{table}\n")
-	return l.flush()
 }
 
 func (l *latexTableWriter) writeHead() {
@@ -140,7 +164,7 @@ func (l *latexTableWriter) writeHeaders() {
 }
 
 func (l *latexTableWriter) writeHeader(idx int, hdr *Header) {
-	width := l.d.GetIdxWidth(idx)
+	width := l.d.GetIdxDisplayWidth(idx)
 	padded := padString(hdr.Title, width)
 	l.writeEscaped(padded)
 
@@ -157,13 +181,16 @@ func (l *latexTableWriter) writeRows() {
 
 func (l *latexTableWriter) writeRow(r *Row) {
 	for idx, item := range r.Items() {
+		if l.d.isNull(r, idx) {
+			item = l.opts.NullPlaceholder
+		}
 		l.writeItem(idx, item)
 	}
 	l.writeString(" \\\\ \\hline\n")
 }
 
 func (l *latexTableWriter) writeItem(idx int, item string) {
-	width := l.d.GetIdxWidth(idx)
+	width := l.d.GetIdxDisplayWidth(idx)
 	padded := padString(item, width)
 	l.writeEscaped(padded)
 
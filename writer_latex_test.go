@@ -150,6 +150,85 @@ John       & Malkovich & 42  \\ \hline
 	s.Equal(expected, out)
 }
 
+func (s *LatexWriterTestSuite) TestWriteDisplayWidth() {
+	opts := &LatexOpts{}
+	w := NewLatexWriter(opts)
+	d := NewDataSet()
+	d.AddHeader("name", "Name")
+	d.AddHeader("city", "City")
+	s.NoError(d.Append(
+		NewRow("Čapek", "Brno"),
+		NewRow("Zeman", "Plzeň"),
+	))
+	out, err := newTestWrite(d, w)
+	expected :=
+		`\begin{table}[h]
+\begin{tabular}{|l|l|}
+\hline
+Name  & City  \\ \hline
+Čapek & Brno  \\ \hline
+Zeman & Plzeň \\ \hline
+\end{tabular}
+\end{table}
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *LatexWriterTestSuite) TestWriteBook() {
+	opts := &LatexOpts{}
+	w := NewLatexWriter(opts)
+	b, err := newTestDatabook()
+	s.Nil(err)
+	out, err := newTestWriteBook(b, w)
+	expected :=
+		`\begin{table}[h]
+\begin{tabular}{|l|l|l|}
+\hline
+First name & Last name & Age \\ \hline
+Julia      & Roberts   & 40  \\ \hline
+John       & Malkovich & 42  \\ \hline
+\end{tabular}
+\caption{Actors}
+\end{table}
+
+\begin{table}[h]
+\begin{tabular}{|l|}
+\hline
+Count \\ \hline
+2     \\ \hline
+\end{tabular}
+\caption{Totals}
+\end{table}
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *LatexWriterTestSuite) TestWriteNull() {
+	opts := &LatexOpts{
+		NullPlaceholder: "-",
+	}
+	w := NewLatexWriter(opts)
+	d, err := newTestNullDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `\begin{table}[h]
+\begin{tabular}{|l|l|l|}
+\hline
+First name & Last name & Age \\ \hline
+Julia      & Roberts   & -   \\ \hline
+John       & -         & 42  \\ \hline
+\end{tabular}
+\end{table}
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
 func TestLatexWriterTestSuite(t *testing.T) {
 	suite.Run(t, new(LatexWriterTestSuite))
 }
@@ -0,0 +1,192 @@
+package tabular
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// MarkdownOpts represents options passed to the Markdown writer.
+type MarkdownOpts struct {
+	Align map[string]Alignment
+
+	// NullPlaceholder is written in place of null values.
+	NullPlaceholder string
+}
+
+// NewMarkdownWriter creates a new Markdown dataset writer.
+func NewMarkdownWriter(opts *MarkdownOpts) *MarkdownWriter {
+	w := &MarkdownWriter{opts}
+	return w
+}
+
+// MarkdownWriter represents a GitHub flavored Markdown dataset writer.
+type MarkdownWriter struct {
+	opts *MarkdownOpts
+}
+
+// Name returns name of the writer.
+func (wm *MarkdownWriter) Name() string {
+	return "markdown"
+}
+
+// NeedsHeaders returns true if headers are required.
+func (wm *MarkdownWriter) NeedsHeaders() bool {
+	return true
+}
+
+// Write writes dataset to writer.
+func (wm *MarkdownWriter) Write(d *Dataset, w io.Writer) error {
+	tw := newMarkdownTableWriter(d, w, wm.opts)
+	return tw.write()
+}
+
+var markdownReplacements = []string{
+	"\\", "\\\\",
+	"|", "\\|",
+	"\r\n", "<br>",
+	"\n", "<br>",
+	"\r", "<br>",
+}
+
+// minimal width of the delimiter row cell
+const markdownMinWidth = 3
+
+func newMarkdownTableWriter(d *Dataset, w io.Writer, opts *MarkdownOpts) *markdownTableWriter {
+	return &markdownTableWriter{
+		d:        d,
+		w:        bufio.NewWriter(w),
+		opts:     opts,
+		replacer: strings.NewReplacer(markdownReplacements...),
+	}
+}
+
+type markdownTableWriter struct {
+	d    *Dataset
+	w    *bufio.Writer
+	opts *MarkdownOpts
+	err  error
+
+	replacer *strings.Replacer
+	widths   []int
+}
+
+func (m *markdownTableWriter) write() error {
+	m.computeWidths()
+	m.writeHeaders()
+	m.writeDelimiters()
+	m.writeRows()
+	return m.flush()
+}
+
+func (m *markdownTableWriter) computeWidths() {
+	m.widths = make([]int, m.d.HeaderCount())
+	for idx, hdr := range m.d.Headers() {
+		m.widths[idx] = m.d.GetIdxDisplayWidth(idx)
+		m.updateWidth(idx, hdr.Title)
+		if m.widths[idx] < markdownMinWidth {
+			m.widths[idx] = markdownMinWidth
+		}
+	}
+
+	nullWidth := displayWidth(m.escapeString(m.opts.NullPlaceholder))
+	for _, row := range m.d.Rows() {
+		for idx, item := range row.Items() {
+			if m.d.isNull(row, idx) {
+				m.growWidth(idx, nullWidth)
+				continue
+			}
+			m.updateWidth(idx, item)
+		}
+	}
+}
+
+func (m *markdownTableWriter) updateWidth(idx int, s string) {
+	// escaping makes cells longer than the tracked column width
+	if escaped := m.escapeString(s); escaped != s {
+		m.growWidth(idx, displayWidth(escaped))
+	}
+}
+
+func (m *markdownTableWriter) growWidth(idx int, width int) {
+	if width > m.widths[idx] {
+		m.widths[idx] = width
+	}
+}
+
+func (m *markdownTableWriter) writeHeaders() {
+	for idx, hdr := range m.d.Headers() {
+		m.writeCell(idx, hdr.Title, m.align(idx))
+	}
+	m.writeString("|\n")
+}
+
+func (m *markdownTableWriter) writeDelimiters() {
+	for idx := range m.d.Headers() {
+		m.writeString("| ")
+		m.writeString(m.delimiter(m.widths[idx], m.align(idx)))
+		m.writeString(" ")
+	}
+	m.writeString("|\n")
+}
+
+func (m *markdownTableWriter) delimiter(width int, align Alignment) string {
+	switch align {
+	case AlignLeft:
+		return ":" + strings.Repeat("-", width-1)
+	case AlignCenter:
+		return ":" + strings.Repeat("-", width-2) + ":"
+	case AlignRight:
+		return strings.Repeat("-", width-1) + ":"
+	default:
+		return strings.Repeat("-", width)
+	}
+}
+
+func (m *markdownTableWriter) writeRows() {
+	for _, row := range m.d.Rows() {
+		m.writeRow(row)
+	}
+}
+
+func (m *markdownTableWriter) writeRow(r *Row) {
+	for idx, item := range r.Items() {
+		if m.d.isNull(r, idx) {
+			item = m.opts.NullPlaceholder
+		}
+		m.writeCell(idx, item, m.align(idx))
+	}
+	m.writeString("|\n")
+}
+
+func (m *markdownTableWriter) writeCell(idx int, s string, align Alignment) {
+	m.writeString("| ")
+	m.writeString(alignString(m.escapeString(s), m.widths[idx], align))
+	m.writeString(" ")
+}
+
+func (m *markdownTableWriter) align(idx int) Alignment {
+	if h, ok := m.d.GetHeader(idx); ok {
+		return m.opts.Align[h.Key]
+	}
+	return AlignDefault
+}
+
+func (m *markdownTableWriter) writeString(s string) {
+	if m.err != nil {
+		return
+	}
+	_, err := m.w.WriteString(s)
+	m.err = err
+}
+
+func (m *markdownTableWriter) escapeString(s string) string {
+	return m.replacer.Replace(s)
+}
+
+func (m *markdownTableWriter) flush() error {
+	if m.err != nil {
+		return m.err
+	}
+	return m.w.Flush()
+}
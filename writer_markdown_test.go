@@ -0,0 +1,90 @@
+package tabular
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MarkdownWriterTestSuite struct {
+	suite.Suite
+}
+
+func (s *MarkdownWriterTestSuite) TestWrite() {
+	opts := &MarkdownOpts{}
+	w := NewMarkdownWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `| First name | Last name | Age |
+| ---------- | --------- | --- |
+| Julia      | Roberts   | 40  |
+| John       | Malkovich | 42  |
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *MarkdownWriterTestSuite) TestWriteAlign() {
+	opts := &MarkdownOpts{
+		Align: map[string]Alignment{
+			"name":    AlignLeft,
+			"surname": AlignCenter,
+			"age":     AlignRight,
+		},
+	}
+	w := NewMarkdownWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `| First name | Last name | Age |
+| :--------- | :-------: | --: |
+| Julia      |  Roberts  |  40 |
+| John       | Malkovich |  42 |
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *MarkdownWriterTestSuite) TestWriteEscape() {
+	opts := &MarkdownOpts{}
+	w := NewMarkdownWriter(opts)
+	d := NewDataSet()
+	d.AddHeader("expr", "Expr")
+	d.AddHeader("note", "Note")
+	s.NoError(d.Append(NewRow("a|b", "line1\nline2")))
+	s.NoError(d.Append(NewRow(`x\|y`, `c:\tmp`)))
+	out, err := newTestWrite(d, w)
+	expected := `| Expr   | Note           |
+| ------ | -------------- |
+| a\|b   | line1<br>line2 |
+| x\\\|y | c:\\tmp        |
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *MarkdownWriterTestSuite) TestWriteNull() {
+	opts := &MarkdownOpts{
+		NullPlaceholder: "(null)",
+	}
+	w := NewMarkdownWriter(opts)
+	d, err := newTestNullDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `| First name | Last name | Age    |
+| ---------- | --------- | ------ |
+| Julia      | Roberts   | (null) |
+| John       | (null)    | 42     |
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func TestMarkdownWriterTestSuite(t *testing.T) {
+	suite.Run(t, new(MarkdownWriterTestSuite))
+}
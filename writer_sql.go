@@ -75,8 +75,14 @@ func (stw *sqlTableWriter) cols() []string {
 
 func (stw *sqlTableWriter) vals(row *Row) []interface{} {
 	res := make([]interface{}, 0, row.Len())
-	for _, item := range row.Items() {
-		res = append(res, item)
+	for idx, item := range row.Items() {
+		if hdr, ok := stw.d.GetHeader(idx); ok {
+			res = append(res, hdr.value(row, idx))
+		} else if row.IsNull(idx) {
+			res = append(res, nil)
+		} else {
+			res = append(res, item)
+		}
 	}
 	return res
 }
@@ -3,6 +3,7 @@ package tabular
 import (
 	"database/sql/driver"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/suite"
@@ -61,6 +62,60 @@ func (s *SQLWriterTestSuite) writeTest(table string, mapping map[string]string)
 	s.NoError(err)
 }
 
+func (s *SQLWriterTestSuite) TestWriteTyped() {
+	db, mock, err := sqlmock.New()
+	s.NoError(err)
+	defer db.Close()
+
+	opts := &SQLOpts{
+		DB:    db,
+		Table: "actors",
+	}
+	w := NewSQLWriter(opts)
+	d, err := newTestTypedDataset()
+	s.NoError(err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO actors").
+		WithArgs("Julia", int64(40), 1.75, true, time.Date(1967, 10, 28, 0, 0, 0, 0, time.UTC), "1200.50").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO actors").
+		WithArgs("007", int64(42), nil, false, nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	_, err = newTestWrite(d, w)
+	s.NoError(err)
+	s.NoError(mock.ExpectationsWereMet())
+}
+
+func (s *SQLWriterTestSuite) TestWriteNull() {
+	db, mock, err := sqlmock.New()
+	s.NoError(err)
+	defer db.Close()
+
+	opts := &SQLOpts{
+		DB:    db,
+		Table: "actors",
+	}
+	w := NewSQLWriter(opts)
+	d, err := newTestNullDataset()
+	s.NoError(err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO actors").
+		WithArgs("Julia", "Roberts", nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO actors").
+		WithArgs("John", nil, "42").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	_, err = newTestWrite(d, w)
+	s.NoError(err)
+	s.NoError(mock.ExpectationsWereMet())
+}
+
 func TestSQLWriterTestSuite(t *testing.T) {
 	suite.Run(t, new(SQLWriterTestSuite))
 }
@@ -0,0 +1,380 @@
+package tabular
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// TextStyle represents border style of the text table.
+type TextStyle int
+
+const (
+	// TextPlain draws borders using ASCII characters.
+	TextPlain TextStyle = iota
+
+	// TextGrid draws borders using box-drawing characters with lines between rows.
+	TextGrid
+
+	// TextRounded draws borders using box-drawing characters with rounded corners.
+	TextRounded
+
+	// TextDouble draws borders using double line box-drawing characters.
+	TextDouble
+
+	// TextSimple draws no borders, only a line below headers.
+	TextSimple
+)
+
+// TextOpts represents options passed to the text writer.
+type TextOpts struct {
+	Style    TextStyle
+	Align    map[string]Alignment
+	MaxWidth int
+	Wrap     bool
+
+	// NullPlaceholder is written in place of null values.
+	NullPlaceholder string
+}
+
+// NewTextWriter creates a new text dataset writer.
+func NewTextWriter(opts *TextOpts) *TextWriter {
+	w := &TextWriter{opts}
+	return w
+}
+
+// TextWriter represents a text dataset writer suitable for terminals.
+type TextWriter struct {
+	opts *TextOpts
+}
+
+// Name returns name of the writer.
+func (wt *TextWriter) Name() string {
+	return "text"
+}
+
+// NeedsHeaders returns true if headers are required.
+func (wt *TextWriter) NeedsHeaders() bool {
+	return false
+}
+
+// Write writes dataset to writer.
+func (wt *TextWriter) Write(d *Dataset, w io.Writer) error {
+	tw := newTextTableWriter(d, w, wt.opts)
+	return tw.write()
+}
+
+type textRule struct {
+	left  string
+	cross string
+	right string
+	fill  string
+}
+
+type textStyle struct {
+	top    textRule
+	header textRule
+	row    textRule
+	bottom textRule
+
+	left  string
+	sep   string
+	right string
+	pad   bool
+
+	ellipsis string
+}
+
+var textStyles = map[TextStyle]textStyle{
+	TextPlain: {
+		top:      textRule{"+", "+", "+", "-"},
+		header:   textRule{"+", "+", "+", "-"},
+		bottom:   textRule{"+", "+", "+", "-"},
+		left:     "|",
+		sep:      "|",
+		right:    "|",
+		pad:      true,
+		ellipsis: "...",
+	},
+	TextGrid: {
+		top:      textRule{"┌", "┬", "┐", "─"},
+		header:   textRule{"├", "┼", "┤", "─"},
+		row:      textRule{"├", "┼", "┤", "─"},
+		bottom:   textRule{"└", "┴", "┘", "─"},
+		left:     "│",
+		sep:      "│",
+		right:    "│",
+		pad:      true,
+		ellipsis: "…",
+	},
+	TextRounded: {
+		top:      textRule{"╭", "┬", "╮", "─"},
+		header:   textRule{"├", "┼", "┤", "─"},
+		bottom:   textRule{"╰", "┴", "╯", "─"},
+		left:     "│",
+		sep:      "│",
+		right:    "│",
+		pad:      true,
+		ellipsis: "…",
+	},
+	TextDouble: {
+		top:      textRule{"╔", "╦", "╗", "═"},
+		header:   textRule{"╠", "╬", "╣", "═"},
+		bottom:   textRule{"╚", "╩", "╝", "═"},
+		left:     "║",
+		sep:      "║",
+		right:    "║",
+		pad:      true,
+		ellipsis: "…",
+	},
+	TextSimple: {
+		header:   textRule{"", "  ", "", "-"},
+		sep:      "  ",
+		ellipsis: "...",
+	},
+}
+
+func newTextTableWriter(d *Dataset, w io.Writer, opts *TextOpts) *textTableWriter {
+	style, ok := textStyles[opts.Style]
+	if !ok {
+		style = textStyles[TextPlain]
+	}
+	return &textTableWriter{
+		d:     d,
+		w:     bufio.NewWriter(w),
+		opts:  opts,
+		style: style,
+	}
+}
+
+type textTableWriter struct {
+	d     *Dataset
+	w     *bufio.Writer
+	opts  *TextOpts
+	style textStyle
+	err   error
+
+	cols   int
+	widths []int
+}
+
+func (t *textTableWriter) write() error {
+	t.cols = t.d.cols
+	t.computeWidths()
+
+	t.writeRule(t.style.top)
+	if t.d.HasHeaders() {
+		var titles []string
+		for _, hdr := range t.d.Headers() {
+			titles = append(titles, hdr.Title)
+		}
+		t.writeLines(titles)
+		t.writeRule(t.style.header)
+	}
+
+	for idx, row := range t.d.Rows() {
+		if idx > 0 {
+			t.writeRule(t.style.row)
+		}
+		t.writeLines(t.rowItems(row))
+	}
+	t.writeRule(t.style.bottom)
+
+	return t.flush()
+}
+
+func (t *textTableWriter) computeWidths() {
+	t.widths = make([]int, t.cols)
+	for idx, hdr := range t.d.Headers() {
+		t.updateWidths(idx, hdr.Title)
+	}
+	for _, row := range t.d.Rows() {
+		for idx, item := range t.rowItems(row) {
+			t.updateWidths(idx, item)
+		}
+	}
+}
+
+// rowItems returns items of the row with nulls replaced by the placeholder.
+func (t *textTableWriter) rowItems(r *Row) []string {
+	items := make([]string, len(r.Items()))
+	for idx, item := range r.Items() {
+		if t.d.isNull(r, idx) {
+			item = t.opts.NullPlaceholder
+		}
+		items[idx] = item
+	}
+	return items
+}
+
+func (t *textTableWriter) updateWidths(idx int, s string) {
+	for _, line := range t.cellLines(s) {
+		if w := displayWidth(line); w > t.widths[idx] {
+			t.widths[idx] = w
+		}
+	}
+}
+
+// cellLines splits cell into lines respecting the maximum column width.
+func (t *textTableWriter) cellLines(s string) []string {
+	lines := strings.Split(strings.Replace(s, "\r\n", "\n", -1), "\n")
+	if t.opts.MaxWidth <= 0 {
+		return lines
+	}
+
+	var res []string
+	for _, line := range lines {
+		if displayWidth(line) <= t.opts.MaxWidth {
+			res = append(res, line)
+			continue
+		}
+		if t.opts.Wrap {
+			res = append(res, wrapString(line, t.opts.MaxWidth)...)
+		} else {
+			res = append(res, truncateString(line, t.opts.MaxWidth, t.style.ellipsis))
+		}
+	}
+	return res
+}
+
+func (t *textTableWriter) writeLines(items []string) {
+	cells := make([][]string, len(items))
+	height := 0
+	for idx, item := range items {
+		cells[idx] = t.cellLines(item)
+		if len(cells[idx]) > height {
+			height = len(cells[idx])
+		}
+	}
+
+	for i := 0; i < height; i++ {
+		parts := make([]string, len(cells))
+		for idx, lines := range cells {
+			var line string
+			if i < len(lines) {
+				line = lines[i]
+			}
+			parts[idx] = t.formatCell(idx, line)
+		}
+		t.writeLine(t.style.left, t.style.sep, t.style.right, parts)
+	}
+}
+
+func (t *textTableWriter) formatCell(idx int, s string) string {
+	cell := alignString(s, t.widths[idx], t.align(idx))
+	if t.style.pad {
+		return " " + cell + " "
+	}
+	return cell
+}
+
+func (t *textTableWriter) writeRule(rule textRule) {
+	if rule.fill == "" {
+		return
+	}
+
+	parts := make([]string, t.cols)
+	for idx, w := range t.widths {
+		if t.style.pad {
+			w += 2
+		}
+		parts[idx] = strings.Repeat(rule.fill, w)
+	}
+	t.writeLine(rule.left, rule.cross, rule.right, parts)
+}
+
+func (t *textTableWriter) writeLine(left string, sep string, right string, parts []string) {
+	line := left + strings.Join(parts, sep) + right
+	if right == "" {
+		line = strings.TrimRight(line, " ")
+	}
+	t.writeString(line)
+	t.writeString("\n")
+}
+
+func (t *textTableWriter) align(idx int) Alignment {
+	if h, ok := t.d.GetHeader(idx); ok {
+		return t.opts.Align[h.Key]
+	}
+	return AlignDefault
+}
+
+func (t *textTableWriter) writeString(s string) {
+	if t.err != nil {
+		return
+	}
+	_, err := t.w.WriteString(s)
+	t.err = err
+}
+
+func (t *textTableWriter) flush() error {
+	if t.err != nil {
+		return t.err
+	}
+	return t.w.Flush()
+}
+
+// truncateString truncates s to max width ending with ellipsis, ellipsis
+// wider than max is shortened.
+func truncateString(s string, max int, ellipsis string) string {
+	limit := max - displayWidth(ellipsis)
+	if limit < 0 {
+		short, _ := splitWidth(ellipsis, max)
+		return short
+	}
+	head, _ := splitWidth(s, limit)
+	return head + ellipsis
+}
+
+func wrapString(s string, max int) []string {
+	var (
+		lines []string
+		line  string
+	)
+
+	for _, word := range strings.Fields(s) {
+		for displayWidth(word) > max {
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			head, tail := splitWidth(word, max)
+			if head == "" {
+				// single cluster wider than the column
+				size, _ := nextCluster(word)
+				head, tail = word[:size], word[size:]
+			}
+			lines = append(lines, head)
+			word = tail
+		}
+
+		switch {
+		case line == "":
+			line = word
+		case displayWidth(line)+1+displayWidth(word) <= max:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// splitWidth splits s so that head fits into max cells.
+func splitWidth(s string, max int) (string, string) {
+	total := 0
+	for idx := 0; idx < len(s); {
+		size, w := nextCluster(s[idx:])
+		if total+w > max {
+			return s[:idx], s[idx:]
+		}
+		total += w
+		idx += size
+	}
+	return s, ""
+}
@@ -0,0 +1,245 @@
+package tabular
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TextWriterTestSuite struct {
+	suite.Suite
+}
+
+func (s *TextWriterTestSuite) TestWritePlain() {
+	opts := &TextOpts{}
+	w := NewTextWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `+------------+-----------+-----+
+| First name | Last name | Age |
++------------+-----------+-----+
+| Julia      | Roberts   | 40  |
+| John       | Malkovich | 42  |
++------------+-----------+-----+
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *TextWriterTestSuite) TestWriteGrid() {
+	opts := &TextOpts{
+		Style: TextGrid,
+	}
+	w := NewTextWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `┌────────────┬───────────┬─────┐
+│ First name │ Last name │ Age │
+├────────────┼───────────┼─────┤
+│ Julia      │ Roberts   │ 40  │
+├────────────┼───────────┼─────┤
+│ John       │ Malkovich │ 42  │
+└────────────┴───────────┴─────┘
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *TextWriterTestSuite) TestWriteRounded() {
+	opts := &TextOpts{
+		Style: TextRounded,
+		Align: map[string]Alignment{
+			"surname": AlignCenter,
+			"age":     AlignRight,
+		},
+	}
+	w := NewTextWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `╭────────────┬───────────┬─────╮
+│ First name │ Last name │ Age │
+├────────────┼───────────┼─────┤
+│ Julia      │  Roberts  │  40 │
+│ John       │ Malkovich │  42 │
+╰────────────┴───────────┴─────╯
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *TextWriterTestSuite) TestWriteDouble() {
+	opts := &TextOpts{
+		Style: TextDouble,
+	}
+	w := NewTextWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `╔════════════╦═══════════╦═════╗
+║ First name ║ Last name ║ Age ║
+╠════════════╬═══════════╬═════╣
+║ Julia      ║ Roberts   ║ 40  ║
+║ John       ║ Malkovich ║ 42  ║
+╚════════════╩═══════════╩═════╝
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *TextWriterTestSuite) TestWriteSimple() {
+	opts := &TextOpts{
+		Style: TextSimple,
+	}
+	w := NewTextWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `First name  Last name  Age
+----------  ---------  ---
+Julia       Roberts    40
+John        Malkovich  42
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *TextWriterTestSuite) TestWriteDisplayWidth() {
+	opts := &TextOpts{}
+	w := NewTextWriter(opts)
+	d := NewDataSet()
+	d.AddHeader("name", "Name")
+	d.AddHeader("city", "City")
+	s.NoError(d.Append(
+		NewRow("Čapek", "東京"),
+		NewRow("Jose\u0301", "Praha"),
+	))
+	out, err := newTestWrite(d, w)
+	expected := "+-------+-------+\n" +
+		"| Name  | City  |\n" +
+		"+-------+-------+\n" +
+		"| Čapek | 東京  |\n" +
+		"| Jose\u0301  | Praha |\n" +
+		"+-------+-------+\n"
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *TextWriterTestSuite) TestWriteTruncate() {
+	opts := &TextOpts{
+		MaxWidth: 6,
+	}
+	w := NewTextWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `+--------+--------+-----+
+| Fir... | Las... | Age |
++--------+--------+-----+
+| Julia  | Rob... | 40  |
+| John   | Mal... | 42  |
++--------+--------+-----+
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *TextWriterTestSuite) TestWriteWrap() {
+	opts := &TextOpts{
+		Style:    TextSimple,
+		MaxWidth: 6,
+		Wrap:     true,
+	}
+	w := NewTextWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `First  Last    Age
+name   name
+-----  ------  ---
+Julia  Robert  40
+       s
+John   Malkov  42
+       ich
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *TextWriterTestSuite) TestWriteMultiline() {
+	opts := &TextOpts{}
+	w := NewTextWriter(opts)
+	d := NewDataSet()
+	d.AddHeader("note", "Note")
+	s.NoError(d.Append(NewRow("first\nsecond")))
+	out, err := newTestWrite(d, w)
+	expected := `+--------+
+| Note   |
++--------+
+| first  |
+| second |
++--------+
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *TextWriterTestSuite) TestWriteNull() {
+	opts := &TextOpts{
+		Style:           TextSimple,
+		NullPlaceholder: "(null)",
+	}
+	w := NewTextWriter(opts)
+	d, err := newTestNullDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `First name  Last name  Age
+----------  ---------  ------
+Julia       Roberts    (null)
+John        (null)     42
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *TextWriterTestSuite) TestTruncateString() {
+	s.Equal("Rob...", truncateString("Roberts", 6, "..."))
+	s.Equal("...", truncateString("Roberts", 3, "..."))
+	s.Equal("..", truncateString("Roberts", 2, "..."))
+	s.Equal("R…", truncateString("Roberts", 2, "…"))
+}
+
+func (s *TextWriterTestSuite) TestWriteNarrow() {
+	opts := &TextOpts{
+		Style:    TextSimple,
+		MaxWidth: 2,
+	}
+	w := NewTextWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `..  ..  ..
+--  --  --
+..  ..  40
+..  ..  42
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func TestTextWriterTestSuite(t *testing.T) {
+	suite.Run(t, new(TextWriterTestSuite))
+}
@@ -0,0 +1,634 @@
+package tabular
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// XLSXOpts represents options passed to the XLSX writer.
+type XLSXOpts struct {
+	SheetName string
+}
+
+// NewXLSXWriter creates a new XLSX dataset writer.
+func NewXLSXWriter(opts *XLSXOpts) *XLSXWriter {
+	w := &XLSXWriter{opts}
+	return w
+}
+
+// XLSXWriter represents a Office Open XML spreadsheet dataset writer.
+type XLSXWriter struct {
+	opts *XLSXOpts
+}
+
+// Name returns name of the writer.
+func (wx *XLSXWriter) Name() string {
+	return "xlsx"
+}
+
+// NeedsHeaders returns true if headers are required.
+func (wx *XLSXWriter) NeedsHeaders() bool {
+	return false
+}
+
+// Write writes dataset to writer.
+func (wx *XLSXWriter) Write(d *Dataset, w io.Writer) error {
+	name := wx.opts.SheetName
+	if name == "" {
+		name = "Sheet1"
+	}
+
+	bw := newXLSXBookWriter(w)
+	bw.addSheet(xlsxSheetName(name), d)
+	return bw.write()
+}
+
+// WriteBook writes databook to writer, each dataset is written as a separate sheet.
+// Sheet names are cleaned up to names accepted by spreadsheet applications,
+// ErrDuplicateSheet is returned when cleaned up names are not unique and
+// ErrEmptySheetName when some name is empty.
+func (wx *XLSXWriter) WriteBook(b *Databook, w io.Writer) error {
+	bw := newXLSXBookWriter(w)
+	names := make(map[string]bool, b.Len())
+	for _, sheet := range b.Sheets() {
+		name := xlsxSheetName(sheet.Name)
+		if name == "" {
+			return ErrEmptySheetName
+		}
+		if names[strings.ToLower(name)] {
+			return ErrDuplicateSheet{name}
+		}
+		names[strings.ToLower(name)] = true
+		bw.addSheet(name, sheet.Dataset)
+	}
+	return bw.write()
+}
+
+const (
+	xlsxNamespace     = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	xlsxRelNamespace  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	xlsxPkgNamespace  = "http://schemas.openxmlformats.org/package/2006/relationships"
+	xlsxTypeNamespace = "http://schemas.openxmlformats.org/package/2006/content-types"
+
+	xlsxRelOfficeDocument = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
+	xlsxRelWorksheet      = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet"
+	xlsxRelStyles         = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles"
+	xlsxRelSharedStrings  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings"
+
+	xlsxTypeWorkbook      = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"
+	xlsxTypeWorksheet     = "application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"
+	xlsxTypeStyles        = "application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"
+	xlsxTypeSharedStrings = "application/vnd.openxmlformats-officedocument.spreadsheetml.sharedStrings+xml"
+	xlsxTypeRelationships = "application/vnd.openxmlformats-package.relationships+xml"
+	xlsxTypeXML           = "application/xml"
+
+	// style index of the bold header cells in styles.xml
+	xlsxHeaderStyle = 1
+)
+
+var numericRegexp = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// isNumeric reports whether s is a plain decimal number which can be
+// stored as a number without changing its textual representation.
+func isNumeric(s string) bool {
+	return numericRegexp.MatchString(s)
+}
+
+// spreadsheet applications keep only 15 significant digits of numbers
+const xlsxMaxDigits = 15
+
+// significantDigits returns count of significant digits of number s.
+func significantDigits(s string) int {
+	if idx := strings.IndexAny(s, "eE"); idx >= 0 {
+		s = s[:idx]
+	}
+	s = strings.TrimLeft(strings.Replace(strings.TrimPrefix(s, "-"), ".", "", 1), "0")
+	return len(s)
+}
+
+type xlsxContentTypes struct {
+	XMLName   xml.Name              `xml:"Types"`
+	Xmlns     string                `xml:"xmlns,attr"`
+	Defaults  []xlsxContentDefault  `xml:"Default"`
+	Overrides []xlsxContentOverride `xml:"Override"`
+}
+
+type xlsxContentDefault struct {
+	Extension   string `xml:",attr"`
+	ContentType string `xml:",attr"`
+}
+
+type xlsxContentOverride struct {
+	PartName    string `xml:",attr"`
+	ContentType string `xml:",attr"`
+}
+
+type xlsxRelationships struct {
+	XMLName       xml.Name           `xml:"Relationships"`
+	Xmlns         string             `xml:"xmlns,attr"`
+	Relationships []xlsxRelationship `xml:"Relationship"`
+}
+
+type xlsxRelationship struct {
+	ID     string `xml:"Id,attr"`
+	Type   string `xml:",attr"`
+	Target string `xml:",attr"`
+}
+
+type xlsxWorkbook struct {
+	XMLName xml.Name          `xml:"workbook"`
+	Xmlns   string            `xml:"xmlns,attr"`
+	XmlnsR  string            `xml:"xmlns:r,attr"`
+	Sheets  []xlsxWorkbookRef `xml:"sheets>sheet"`
+}
+
+type xlsxWorkbookRef struct {
+	Name    string `xml:"name,attr"`
+	SheetID int    `xml:"sheetId,attr"`
+	RelID   string `xml:"r:id,attr"`
+}
+
+type xlsxStyleSheet struct {
+	XMLName    xml.Name       `xml:"styleSheet"`
+	Xmlns      string         `xml:"xmlns,attr"`
+	Fonts      xlsxFonts      `xml:"fonts"`
+	Fills      xlsxFills      `xml:"fills"`
+	Borders    xlsxBorders    `xml:"borders"`
+	CellXfs    xlsxCellXfs    `xml:"cellXfs"`
+	CellStyles xlsxCellStyles `xml:"cellStyles"`
+}
+
+type xlsxFonts struct {
+	Count int        `xml:"count,attr"`
+	Fonts []xlsxFont `xml:"font"`
+}
+
+type xlsxFills struct {
+	Count int        `xml:"count,attr"`
+	Fills []xlsxFill `xml:"fill"`
+}
+
+type xlsxBorders struct {
+	Count   int          `xml:"count,attr"`
+	Borders []xlsxBorder `xml:"border"`
+}
+
+type xlsxBorder struct{}
+
+type xlsxCellXfs struct {
+	Count int          `xml:"count,attr"`
+	Xfs   []xlsxCellXf `xml:"xf"`
+}
+
+type xlsxCellStyles struct {
+	Count  int             `xml:"count,attr"`
+	Styles []xlsxCellStyle `xml:"cellStyle"`
+}
+
+type xlsxFont struct {
+	Bold *struct{} `xml:"b"`
+	Size xlsxVal   `xml:"sz"`
+	Name xlsxVal   `xml:"name"`
+}
+
+type xlsxFill struct {
+	PatternFill xlsxPatternFill `xml:"patternFill"`
+}
+
+type xlsxPatternFill struct {
+	PatternType string `xml:"patternType,attr"`
+}
+
+type xlsxCellXf struct {
+	NumFmtID  int `xml:"numFmtId,attr"`
+	FontID    int `xml:"fontId,attr"`
+	FillID    int `xml:"fillId,attr"`
+	BorderID  int `xml:"borderId,attr"`
+	ApplyFont int `xml:"applyFont,attr,omitempty"`
+}
+
+type xlsxCellStyle struct {
+	Name      string `xml:"name,attr"`
+	XfID      int    `xml:"xfId,attr"`
+	BuiltinID int    `xml:"builtinId,attr"`
+}
+
+type xlsxVal struct {
+	Val string `xml:"val,attr"`
+}
+
+type xlsxSharedStrings struct {
+	XMLName     xml.Name         `xml:"sst"`
+	Xmlns       string           `xml:"xmlns,attr"`
+	Count       int              `xml:"count,attr"`
+	UniqueCount int              `xml:"uniqueCount,attr"`
+	Items       []xlsxStringItem `xml:"si"`
+}
+
+type xlsxStringItem struct {
+	Text xlsxText `xml:"t"`
+}
+
+type xlsxText struct {
+	Space string `xml:"xml:space,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+type xlsxWorksheet struct {
+	XMLName    xml.Name        `xml:"worksheet"`
+	Xmlns      string          `xml:"xmlns,attr"`
+	SheetViews *xlsxSheetViews `xml:"sheetViews"`
+	Cols       *xlsxCols       `xml:"cols"`
+	Rows       []xlsxRow       `xml:"sheetData>row"`
+}
+
+type xlsxSheetViews struct {
+	SheetView xlsxSheetView `xml:"sheetView"`
+}
+
+type xlsxSheetView struct {
+	WorkbookViewID int       `xml:"workbookViewId,attr"`
+	Pane           *xlsxPane `xml:"pane"`
+}
+
+type xlsxPane struct {
+	YSplit      int    `xml:"ySplit,attr"`
+	TopLeftCell string `xml:"topLeftCell,attr"`
+	ActivePane  string `xml:"activePane,attr"`
+	State       string `xml:"state,attr"`
+}
+
+type xlsxCols struct {
+	Cols []xlsxCol `xml:"col"`
+}
+
+type xlsxCol struct {
+	Min         int     `xml:"min,attr"`
+	Max         int     `xml:"max,attr"`
+	Width       float64 `xml:"width,attr"`
+	CustomWidth int     `xml:"customWidth,attr"`
+}
+
+type xlsxRow struct {
+	R     int        `xml:"r,attr"`
+	Cells []xlsxCell `xml:"c"`
+}
+
+type xlsxCell struct {
+	R     string `xml:"r,attr"`
+	S     int    `xml:"s,attr,omitempty"`
+	T     string `xml:"t,attr,omitempty"`
+	Value string `xml:"v"`
+}
+
+type xlsxSheet struct {
+	name string
+	d    *Dataset
+}
+
+func newXLSXBookWriter(w io.Writer) *xlsxBookWriter {
+	return &xlsxBookWriter{
+		zw:        zip.NewWriter(w),
+		stringIdx: make(map[string]int),
+	}
+}
+
+type xlsxBookWriter struct {
+	zw     *zip.Writer
+	sheets []xlsxSheet
+
+	stringIdx   map[string]int
+	stringItems []xlsxStringItem
+	stringCount int
+}
+
+func (x *xlsxBookWriter) addSheet(name string, d *Dataset) {
+	x.sheets = append(x.sheets, xlsxSheet{name, d})
+}
+
+func (x *xlsxBookWriter) write() error {
+	// worksheets have to be built first to collect the shared strings
+	worksheets := make([]*xlsxWorksheet, 0, len(x.sheets))
+	for _, sheet := range x.sheets {
+		worksheets = append(worksheets, x.worksheet(sheet.d))
+	}
+
+	files := []struct {
+		name string
+		v    interface{}
+	}{
+		{"[Content_Types].xml", x.contentTypes()},
+		{"_rels/.rels", x.rootRels()},
+		{"xl/workbook.xml", x.workbook()},
+		{"xl/_rels/workbook.xml.rels", x.workbookRels()},
+		{"xl/styles.xml", x.styles()},
+		{"xl/sharedStrings.xml", x.sharedStrings()},
+	}
+	for _, f := range files {
+		if err := x.writeFile(f.name, f.v); err != nil {
+			return err
+		}
+	}
+
+	for idx, ws := range worksheets {
+		if err := x.writeFile(xlsxSheetPath(idx), ws); err != nil {
+			return err
+		}
+	}
+
+	return x.zw.Close()
+}
+
+func (x *xlsxBookWriter) writeFile(name string, v interface{}) error {
+	fw, err := x.zw.Create(name)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(fw, xml.Header); err != nil {
+		return err
+	}
+	return xml.NewEncoder(fw).Encode(v)
+}
+
+func (x *xlsxBookWriter) contentTypes() *xlsxContentTypes {
+	ct := &xlsxContentTypes{
+		Xmlns: xlsxTypeNamespace,
+		Defaults: []xlsxContentDefault{
+			{"rels", xlsxTypeRelationships},
+			{"xml", xlsxTypeXML},
+		},
+		Overrides: []xlsxContentOverride{
+			{"/xl/workbook.xml", xlsxTypeWorkbook},
+			{"/xl/styles.xml", xlsxTypeStyles},
+			{"/xl/sharedStrings.xml", xlsxTypeSharedStrings},
+		},
+	}
+	for idx := range x.sheets {
+		ct.Overrides = append(ct.Overrides, xlsxContentOverride{
+			PartName:    "/" + xlsxSheetPath(idx),
+			ContentType: xlsxTypeWorksheet,
+		})
+	}
+	return ct
+}
+
+func (x *xlsxBookWriter) rootRels() *xlsxRelationships {
+	return &xlsxRelationships{
+		Xmlns: xlsxPkgNamespace,
+		Relationships: []xlsxRelationship{
+			{"rId1", xlsxRelOfficeDocument, "xl/workbook.xml"},
+		},
+	}
+}
+
+func (x *xlsxBookWriter) workbook() *xlsxWorkbook {
+	wb := &xlsxWorkbook{
+		Xmlns:  xlsxNamespace,
+		XmlnsR: xlsxRelNamespace,
+	}
+	for idx, sheet := range x.sheets {
+		wb.Sheets = append(wb.Sheets, xlsxWorkbookRef{
+			Name:    sheet.name,
+			SheetID: idx + 1,
+			RelID:   "rId" + strconv.Itoa(idx+1),
+		})
+	}
+	return wb
+}
+
+func (x *xlsxBookWriter) workbookRels() *xlsxRelationships {
+	rels := &xlsxRelationships{
+		Xmlns: xlsxPkgNamespace,
+	}
+	for idx := range x.sheets {
+		rels.Relationships = append(rels.Relationships, xlsxRelationship{
+			ID:     "rId" + strconv.Itoa(idx+1),
+			Type:   xlsxRelWorksheet,
+			Target: strings.TrimPrefix(xlsxSheetPath(idx), "xl/"),
+		})
+	}
+	next := len(x.sheets) + 1
+	rels.Relationships = append(rels.Relationships,
+		xlsxRelationship{"rId" + strconv.Itoa(next), xlsxRelStyles, "styles.xml"},
+		xlsxRelationship{"rId" + strconv.Itoa(next+1), xlsxRelSharedStrings, "sharedStrings.xml"},
+	)
+	return rels
+}
+
+func (x *xlsxBookWriter) styles() *xlsxStyleSheet {
+	return &xlsxStyleSheet{
+		Xmlns: xlsxNamespace,
+		Fonts: xlsxFonts{
+			Count: 2,
+			Fonts: []xlsxFont{
+				{Size: xlsxVal{"11"}, Name: xlsxVal{"Calibri"}},
+				{Bold: &struct{}{}, Size: xlsxVal{"11"}, Name: xlsxVal{"Calibri"}},
+			},
+		},
+		Fills: xlsxFills{
+			Count: 2,
+			Fills: []xlsxFill{
+				{xlsxPatternFill{"none"}},
+				{xlsxPatternFill{"gray125"}},
+			},
+		},
+		Borders: xlsxBorders{
+			Count:   1,
+			Borders: []xlsxBorder{{}},
+		},
+		CellXfs: xlsxCellXfs{
+			Count: 2,
+			Xfs: []xlsxCellXf{
+				{},
+				{FontID: 1, ApplyFont: 1},
+			},
+		},
+		CellStyles: xlsxCellStyles{
+			Count: 1,
+			Styles: []xlsxCellStyle{
+				{Name: "Normal"},
+			},
+		},
+	}
+}
+
+func (x *xlsxBookWriter) sharedStrings() *xlsxSharedStrings {
+	return &xlsxSharedStrings{
+		Xmlns:       xlsxNamespace,
+		Count:       x.stringCount,
+		UniqueCount: len(x.stringItems),
+		Items:       x.stringItems,
+	}
+}
+
+func (x *xlsxBookWriter) worksheet(d *Dataset) *xlsxWorksheet {
+	ws := &xlsxWorksheet{
+		Xmlns: xlsxNamespace,
+		SheetViews: &xlsxSheetViews{
+			SheetView: xlsxSheetView{},
+		},
+	}
+
+	if d.cols > 0 {
+		ws.Cols = &xlsxCols{}
+		for idx := 0; idx < d.cols; idx++ {
+			ws.Cols.Cols = append(ws.Cols.Cols, xlsxCol{
+				Min:         idx + 1,
+				Max:         idx + 1,
+				Width:       float64(d.GetIdxDisplayWidth(idx) + 2),
+				CustomWidth: 1,
+			})
+		}
+	}
+
+	rowNum := 1
+	if d.HasHeaders() {
+		ws.SheetViews.SheetView.Pane = &xlsxPane{
+			YSplit:      1,
+			TopLeftCell: "A2",
+			ActivePane:  "bottomLeft",
+			State:       "frozen",
+		}
+
+		row := xlsxRow{R: rowNum}
+		for idx, hdr := range d.Headers() {
+			cell := x.stringCell(idx, rowNum, hdr.Title)
+			cell.S = xlsxHeaderStyle
+			row.Cells = append(row.Cells, cell)
+		}
+		ws.Rows = append(ws.Rows, row)
+		rowNum++
+	}
+
+	for _, r := range d.Rows() {
+		row := xlsxRow{R: rowNum}
+		for idx, item := range r.Items() {
+			// empty and null items are left out, spreadsheets have no other null
+			if item == "" || r.IsNull(idx) {
+				continue
+			}
+			hdr, _ := d.GetHeader(idx)
+			row.Cells = append(row.Cells, x.cell(hdr, idx, rowNum, item))
+		}
+		ws.Rows = append(ws.Rows, row)
+		rowNum++
+	}
+
+	return ws
+}
+
+func (x *xlsxBookWriter) cell(hdr *Header, col int, row int, val string) xlsxCell {
+	typ := TypeAny
+	if hdr != nil {
+		typ = hdr.Type
+	}
+
+	switch {
+	case typ.IsNumeric():
+		// floats are inexact anyway, exact ints and decimals which would be
+		// rounded are kept as text
+		formatted := formatValue(typ, val)
+		if isNumeric(formatted) && (typ == TypeFloat || significantDigits(formatted) <= xlsxMaxDigits) {
+			return x.numberCell(col, row, formatted)
+		}
+	case typ == TypeBool:
+		if b, err := strconv.ParseBool(val); err == nil {
+			return x.boolCell(col, row, b)
+		}
+	case typ == TypeAny:
+		if isNumeric(val) && significantDigits(val) <= xlsxMaxDigits {
+			return x.numberCell(col, row, val)
+		}
+	}
+	return x.stringCell(col, row, val)
+}
+
+func (x *xlsxBookWriter) numberCell(col int, row int, val string) xlsxCell {
+	return xlsxCell{
+		R:     xlsxCellName(col, row),
+		Value: val,
+	}
+}
+
+func (x *xlsxBookWriter) boolCell(col int, row int, val bool) xlsxCell {
+	cell := xlsxCell{
+		R:     xlsxCellName(col, row),
+		T:     "b",
+		Value: "0",
+	}
+	if val {
+		cell.Value = "1"
+	}
+	return cell
+}
+
+func (x *xlsxBookWriter) stringCell(col int, row int, val string) xlsxCell {
+	return xlsxCell{
+		R:     xlsxCellName(col, row),
+		T:     "s",
+		Value: strconv.Itoa(x.sharedString(val)),
+	}
+}
+
+func (x *xlsxBookWriter) sharedString(s string) int {
+	x.stringCount++
+	if idx, ok := x.stringIdx[s]; ok {
+		return idx
+	}
+
+	item := xlsxStringItem{
+		Text: xlsxText{Value: s},
+	}
+	if s != "" && (s[0] == ' ' || s[len(s)-1] == ' ') {
+		item.Text.Space = "preserve"
+	}
+
+	idx := len(x.stringItems)
+	x.stringIdx[s] = idx
+	x.stringItems = append(x.stringItems, item)
+	return idx
+}
+
+// spreadsheet applications limit sheet names to 31 characters without []:*?/\
+var xlsxSheetNameReplacer = strings.NewReplacer(
+	"[", "(",
+	"]", ")",
+	":", "-",
+	"*", "-",
+	"?", "-",
+	"/", "-",
+	"\\", "-",
+)
+
+const xlsxMaxSheetName = 31
+
+func xlsxSheetName(name string) string {
+	name = xlsxSheetNameReplacer.Replace(name)
+	if runes := []rune(name); len(runes) > xlsxMaxSheetName {
+		name = string(runes[:xlsxMaxSheetName])
+	}
+	return name
+}
+
+func xlsxSheetPath(idx int) string {
+	return "xl/worksheets/sheet" + strconv.Itoa(idx+1) + ".xml"
+}
+
+// xlsxColName returns spreadsheet column name for zero based index.
+func xlsxColName(idx int) string {
+	name := ""
+	for idx >= 0 {
+		name = string(rune('A'+idx%26)) + name
+		idx = idx/26 - 1
+	}
+	return name
+}
+
+// xlsxCellName returns spreadsheet cell reference for zero based column and one based row.
+func xlsxCellName(col int, row int) string {
+	return xlsxColName(col) + strconv.Itoa(row)
+}
@@ -0,0 +1,205 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type XLSXWriterTestSuite struct {
+	suite.Suite
+}
+
+func (s *XLSXWriterTestSuite) TestWrite() {
+	opts := &XLSXOpts{
+		SheetName: "Actors",
+	}
+	w := NewXLSXWriter(opts)
+	d, err := newTestDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	s.Nil(err)
+
+	files := s.readZip(out)
+	s.Len(files, 7)
+	s.Contains(files, "[Content_Types].xml")
+	s.Contains(files, "_rels/.rels")
+	s.Contains(files, "xl/_rels/workbook.xml.rels")
+	s.Contains(files, "xl/styles.xml")
+
+	s.Contains(files["xl/workbook.xml"], `<sheet name="Actors" sheetId="1" r:id="rId1"></sheet>`)
+	s.Contains(files["xl/sharedStrings.xml"], `count="7" uniqueCount="7"><si><t>First name</t></si><si><t>Last name</t></si>`)
+
+	sheet := files["xl/worksheets/sheet1.xml"]
+	s.Contains(sheet, `<pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"></pane>`)
+	s.Contains(sheet, `<col min="1" max="1" width="12" customWidth="1"></col>`)
+	s.Contains(sheet, `<row r="1"><c r="A1" s="1" t="s"><v>0</v></c><c r="B1" s="1" t="s"><v>1</v></c><c r="C1" s="1" t="s"><v>2</v></c></row>`)
+	s.Contains(sheet, `<row r="2"><c r="A2" t="s"><v>3</v></c><c r="B2" t="s"><v>4</v></c><c r="C2"><v>40</v></c></row>`)
+}
+
+func (s *XLSXWriterTestSuite) TestWriteWithoutHeaders() {
+	opts := &XLSXOpts{}
+	w := NewXLSXWriter(opts)
+	d := NewDataSet()
+	s.NoError(d.Append(
+		NewRow("Julia", "007", " padded "),
+		NewRow("Julia", "-1.5e3", ""),
+	))
+	out, err := newTestWrite(d, w)
+	s.Nil(err)
+
+	files := s.readZip(out)
+	s.Contains(files["xl/workbook.xml"], `<sheet name="Sheet1"`)
+	s.Contains(files["xl/sharedStrings.xml"], `count="4" uniqueCount="3"><si><t>Julia</t></si><si><t>007</t></si><si><t xml:space="preserve"> padded </t></si>`)
+
+	sheet := files["xl/worksheets/sheet1.xml"]
+	s.NotContains(sheet, "<pane")
+	s.Contains(sheet, `<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="s"><v>2</v></c></row>`)
+	s.Contains(sheet, `<row r="2"><c r="A2" t="s"><v>0</v></c><c r="B2"><v>-1.5e3</v></c></row>`)
+}
+
+func (s *XLSXWriterTestSuite) TestWriteSheetName() {
+	w := NewXLSXWriter(&XLSXOpts{
+		SheetName: "Q1/Q2 [draft]",
+	})
+	d, err := newTestDataset()
+	s.Require().NoError(err)
+	out, err := newTestWrite(d, w)
+	s.NoError(err)
+	s.Contains(s.readZip(out)["xl/workbook.xml"], `<sheet name="Q1-Q2 (draft)" sheetId="1" r:id="rId1"></sheet>`)
+}
+
+func (s *XLSXWriterTestSuite) TestWriteLongNumbers() {
+	d := NewDataSet()
+	s.Require().NoError(d.Append(NewRow("4111111111111111", "123456789012345", "-0.000123456789012345", "1.5e300")))
+	out, err := newTestWrite(d, NewXLSXWriter(&XLSXOpts{}))
+	s.NoError(err)
+
+	sheet := s.readZip(out)["xl/worksheets/sheet1.xml"]
+	s.Contains(sheet, `<c r="A1" t="s"><v>0</v></c><c r="B1"><v>123456789012345</v></c><c r="C1"><v>-0.000123456789012345</v></c><c r="D1"><v>1.5e300</v></c>`)
+}
+
+func (s *XLSXWriterTestSuite) TestWriteTypedLongNumbers() {
+	d := NewDataSet()
+	d.AddTypedHeader("id", "ID", TypeInt, false)
+	d.AddTypedHeader("amount", "Amount", TypeDecimal, false)
+	d.AddTypedHeader("ratio", "Ratio", TypeFloat, false)
+	s.Require().NoError(d.Append(
+		NewRow("1234567890123456789", "12345678901234567890.12", "0.12345678901234567"),
+		NewRow("42", "1200.50", "1.5"),
+	))
+	out, err := newTestWrite(d, NewXLSXWriter(&XLSXOpts{}))
+	s.NoError(err)
+
+	sheet := s.readZip(out)["xl/worksheets/sheet1.xml"]
+	s.Contains(sheet, `<row r="2"><c r="A2" t="s"><v>3</v></c><c r="B2" t="s"><v>4</v></c><c r="C2"><v>0.12345678901234567</v></c></row>`)
+	s.Contains(sheet, `<row r="3"><c r="A3"><v>42</v></c><c r="B3"><v>1200.50</v></c><c r="C3"><v>1.5</v></c></row>`)
+}
+
+func (s *XLSXWriterTestSuite) TestColName() {
+	s.Equal("A", xlsxColName(0))
+	s.Equal("Z", xlsxColName(25))
+	s.Equal("AA", xlsxColName(26))
+	s.Equal("AZ", xlsxColName(51))
+	s.Equal("BA", xlsxColName(52))
+	s.Equal("ZZ", xlsxColName(701))
+	s.Equal("AAA", xlsxColName(702))
+	s.Equal("C12", xlsxCellName(2, 12))
+}
+
+func (s *XLSXWriterTestSuite) readZip(data string) map[string]string {
+	zr, err := zip.NewReader(bytes.NewReader([]byte(data)), int64(len(data)))
+	s.Require().NoError(err)
+
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		s.Require().NoError(err)
+		b, err := ioutil.ReadAll(rc)
+		s.Require().NoError(err)
+		s.Require().NoError(rc.Close())
+		files[f.Name] = string(b)
+	}
+	return files
+}
+
+func (s *XLSXWriterTestSuite) TestWriteBook() {
+	opts := &XLSXOpts{}
+	w := NewXLSXWriter(opts)
+	b, err := newTestDatabook()
+	s.Nil(err)
+	s.NoError(b.AddSheet("Errors: [a/b]", b.Sheets()[1].Dataset))
+	out, err := newTestWriteBook(b, w)
+	s.Nil(err)
+
+	files := s.readZip(out)
+	s.Contains(files["xl/workbook.xml"], `<sheet name="Actors" sheetId="1" r:id="rId1"></sheet><sheet name="Totals" sheetId="2" r:id="rId2"></sheet><sheet name="Errors- (a-b)" sheetId="3" r:id="rId3"></sheet>`)
+	s.Contains(files["xl/_rels/workbook.xml.rels"], `<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet3.xml"></Relationship><Relationship Id="rId4"`)
+	s.Contains(files["[Content_Types].xml"], `<Override PartName="/xl/worksheets/sheet2.xml"`)
+	s.Contains(files["xl/worksheets/sheet2.xml"], `<row r="1"><c r="A1" s="1" t="s"><v>7</v></c></row><row r="2"><c r="A2"><v>2</v></c></row>`)
+
+	r := NewXLSXReader(&XLSXReaderOpts{
+		Sheet:      "Totals",
+		HasHeaders: true,
+	})
+	d, err := r.Read(strings.NewReader(out))
+	s.NoError(err)
+	s.Equal([]string{"2"}, d.GetColValues("Count"))
+}
+
+func (s *XLSXWriterTestSuite) TestWriteBookDuplicateNames() {
+	w := NewXLSXWriter(&XLSXOpts{})
+	d, err := newTestDataset()
+	s.Require().NoError(err)
+
+	b := NewDatabook()
+	s.NoError(b.AddSheet("a/b", d))
+	s.NoError(b.AddSheet("A-B", d))
+	_, err = newTestWriteBook(b, w)
+	s.Equal(ErrDuplicateSheet{"A-B"}, err)
+
+	b = NewDatabook()
+	s.NoError(b.AddSheet(strings.Repeat("x", 31)+"1", d))
+	s.NoError(b.AddSheet(strings.Repeat("x", 31)+"2", d))
+	_, err = newTestWriteBook(b, w)
+	s.Equal(ErrDuplicateSheet{strings.Repeat("x", 31)}, err)
+
+	b = NewDatabook()
+	s.NoError(b.AddSheet("", d))
+	_, err = newTestWriteBook(b, w)
+	s.Equal(ErrEmptySheetName, err)
+}
+
+func (s *XLSXWriterTestSuite) TestWriteTyped() {
+	opts := &XLSXOpts{}
+	w := NewXLSXWriter(opts)
+	d, err := newTestTypedDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	s.Nil(err)
+
+	sheet := s.readZip(out)["xl/worksheets/sheet1.xml"]
+	s.Contains(sheet, `<row r="2"><c r="A2" t="s"><v>6</v></c><c r="B2"><v>40</v></c><c r="C2"><v>1.75</v></c><c r="D2" t="b"><v>1</v></c><c r="E2" t="s"><v>7</v></c><c r="F2"><v>1200.50</v></c></row>`)
+	s.Contains(sheet, `<row r="3"><c r="A3" t="s"><v>8</v></c><c r="B3"><v>42</v></c><c r="D3" t="b"><v>0</v></c></row>`)
+}
+
+func (s *XLSXWriterTestSuite) TestWriteNull() {
+	opts := &XLSXOpts{}
+	w := NewXLSXWriter(opts)
+	d, err := newTestNullDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	s.Nil(err)
+
+	sheet := s.readZip(out)["xl/worksheets/sheet1.xml"]
+	s.Contains(sheet, `<row r="2"><c r="A2" t="s"><v>3</v></c><c r="B2" t="s"><v>4</v></c></row>`)
+	s.Contains(sheet, `<row r="3"><c r="A3" t="s"><v>5</v></c><c r="C3"><v>42</v></c></row>`)
+}
+
+func TestXLSXWriterTestSuite(t *testing.T) {
+	suite.Run(t, new(XLSXWriterTestSuite))
+}
@@ -12,8 +12,14 @@ type XMLOpts struct {
 
 	RowElem    string
 	ParentElem string
+
+	// NilElems writes null values as elements with xsi:nil attribute,
+	// elements of null values are omitted otherwise.
+	NilElems bool
 }
 
+const xmlSchemaInstance = "http://www.w3.org/2001/XMLSchema-instance"
+
 // NewXMLWriter creates a new XML dataset writer.
 func NewXMLWriter(opts *XMLOpts) *XMLWriter {
 	w := &XMLWriter{opts}
@@ -61,6 +67,12 @@ func (xw xmlWrapper) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		Space: "",
 		Local: xw.writer.opts.ParentElem,
 	}
+	if xw.writer.opts.NilElems {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "xmlns:xsi"},
+			Value: xmlSchemaInstance,
+		})
+	}
 	if err := e.EncodeToken(start); err != nil {
 		return err
 	}
@@ -87,7 +99,7 @@ func (xw xmlWrapper) encodeRow(e *xml.Encoder, row *Row) error {
 	}
 
 	for idx, val := range row.Items() {
-		if err := xw.encodeItem(e, idx, val); err != nil {
+		if err := xw.encodeItem(e, row, idx, val); err != nil {
 			return err
 		}
 	}
@@ -95,7 +107,7 @@ func (xw xmlWrapper) encodeRow(e *xml.Encoder, row *Row) error {
 	return e.EncodeToken(elem.End())
 }
 
-func (xw xmlWrapper) encodeItem(e *xml.Encoder, idx int, val string) error {
+func (xw xmlWrapper) encodeItem(e *xml.Encoder, row *Row, idx int, val string) error {
 	h, ok := xw.d.GetHeader(idx)
 	if !ok {
 		return ErrInvalidHeaderIndex{idx}
@@ -108,5 +120,20 @@ func (xw xmlWrapper) encodeItem(e *xml.Encoder, idx int, val string) error {
 		},
 		Attr: nil,
 	}
+
+	if h.isNull(row, idx) {
+		if !xw.writer.opts.NilElems {
+			return nil
+		}
+		elem.Attr = append(elem.Attr, xml.Attr{
+			Name:  xml.Name{Local: "xsi:nil"},
+			Value: "true",
+		})
+		if err := e.EncodeToken(elem); err != nil {
+			return err
+		}
+		return e.EncodeToken(elem.End())
+	}
+
 	return e.EncodeElement(val, elem)
 }
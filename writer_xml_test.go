@@ -38,6 +38,37 @@ func (s *XMLWriterTestSuite) TestWrite() {
 	s.Equal(expected, out)
 }
 
+func (s *XMLWriterTestSuite) TestWriteNull() {
+	opts := &XMLOpts{
+		RowElem:    "row",
+		ParentElem: "rows",
+	}
+	w := NewXMLWriter(opts)
+	d, err := newTestNullDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `<rows><row><name>Julia</name><surname>Roberts</surname></row><row><name>John</name><age>42</age></row></rows>`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *XMLWriterTestSuite) TestWriteNilElems() {
+	opts := &XMLOpts{
+		RowElem:    "row",
+		ParentElem: "rows",
+		NilElems:   true,
+	}
+	w := NewXMLWriter(opts)
+	d, err := newTestNullDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `<rows xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><row><name>Julia</name><surname>Roberts</surname><age xsi:nil="true"></age></row><row><name>John</name><surname xsi:nil="true"></surname><age>42</age></row></rows>`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
 func TestXMLWriterTestSuite(t *testing.T) {
 	suite.Run(t, new(XMLWriterTestSuite))
 }
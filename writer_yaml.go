@@ -3,6 +3,7 @@ package tabular
 import (
 	"bufio"
 	"io"
+	"strconv"
 	"strings"
 )
 
@@ -37,6 +38,12 @@ func (wy *YAMLWriter) Write(d *Dataset, w io.Writer) error {
 	return tw.write()
 }
 
+// WriteBook writes databook to writer, each dataset is written as a separate document.
+func (wy *YAMLWriter) WriteBook(b *Databook, w io.Writer) error {
+	tw := newYAMLTableWriter(nil, w, wy.opts)
+	return tw.writeBook(b)
+}
+
 // http://symfony.com/doc/current/components/yaml/yaml_format.html
 var yamlReplacements = []string{
 	"&", "\\&",
@@ -66,6 +73,22 @@ type yamlTableWriter struct {
 }
 
 func (y *yamlTableWriter) write() error {
+	y.writeTable()
+	return y.flush()
+}
+
+func (y *yamlTableWriter) writeBook(b *Databook) error {
+	for _, sheet := range b.Sheets() {
+		y.d = sheet.Dataset
+		y.writeString("--- # ")
+		y.writeString(strings.Join(strings.Fields(sheet.Name), " "))
+		y.writeString("\n")
+		y.writeTable()
+	}
+	return y.flush()
+}
+
+func (y *yamlTableWriter) writeTable() {
 	for _, row := range y.d.Rows() {
 		y.writeString("- ")
 		for idx, hdr := range y.d.Headers() {
@@ -74,11 +97,25 @@ func (y *yamlTableWriter) write() error {
 			}
 			y.writeEscaped(hdr.Key)
 			y.writeString(": ")
-			y.writeEscaped(row.Get(idx))
+			y.writeValue(hdr, row, idx)
 			y.writeString("\n")
 		}
 	}
-	return y.flush()
+}
+
+func (y *yamlTableWriter) writeValue(hdr *Header, row *Row, idx int) {
+	val := row.Get(idx)
+	switch {
+	case hdr.isNull(row, idx):
+		y.writeString("null")
+	case hdr.Type.IsNumeric(), hdr.Type == TypeBool:
+		y.writeEscaped(formatValue(hdr.Type, val))
+	case hdr.Type == TypeString:
+		// typed strings are quoted so they are never read as numbers or booleans
+		y.writeString(strconv.Quote(val))
+	default:
+		y.writeEscaped(val)
+	}
 }
 
 func (y *yamlTableWriter) flush() error {
@@ -28,6 +28,69 @@ func (s *YAMLWriterTestSuite) TestWrite() {
 	s.Equal(expected, out)
 }
 
+func (s *YAMLWriterTestSuite) TestWriteBook() {
+	opts := &YAMLOpts{}
+	w := NewYAMLWriter(opts)
+	b, err := newTestDatabook()
+	s.Nil(err)
+	out, err := newTestWriteBook(b, w)
+	expected := `--- # Actors
+- name: Julia
+  surname: Roberts
+  age: 40
+- name: John
+  surname: Malkovich
+  age: 42
+--- # Totals
+- count: 2
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *YAMLWriterTestSuite) TestWriteTyped() {
+	opts := &YAMLOpts{}
+	w := NewYAMLWriter(opts)
+	d, err := newTestTypedDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `- name: "Julia"
+  age: 40
+  height: 1.75
+  active: true
+  born: 1967-10-28
+  salary: 1200.50
+- name: "007"
+  age: 42
+  height: null
+  active: false
+  born: null
+  salary: null
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
+func (s *YAMLWriterTestSuite) TestWriteNull() {
+	opts := &YAMLOpts{}
+	w := NewYAMLWriter(opts)
+	d, err := newTestNullDataset()
+	s.Nil(err)
+	out, err := newTestWrite(d, w)
+	expected := `- name: Julia
+  surname: Roberts
+  age: null
+- name: John
+  surname: null
+  age: 42
+`
+
+	s.Nil(err)
+	s.Equal(expected, out)
+}
+
 func TestYAMLWriterTestSuite(t *testing.T) {
 	suite.Run(t, new(YAMLWriterTestSuite))
 }